@@ -3,6 +3,7 @@ package confluence
 import (
 	"fmt"
 
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -13,7 +14,10 @@ var Cmd = &cobra.Command{
 	Short:   "Confluence operations",
 	Long:    `Commands for interacting with Confluence: spaces, pages, search, and content management.`,
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-		return validateConfig()
+		if err := validateConfig(); err != nil {
+			return err
+		}
+		return output.Validate()
 	},
 }
 
@@ -2,12 +2,12 @@ package confluence
 
 import (
 	"bufio"
-	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
 
 	"github.com/joselrodrigues/atlassian/internal/confluence"
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -28,7 +28,6 @@ Examples:
 		title, _ := cmd.Flags().GetString("title")
 		parentID, _ := cmd.Flags().GetString("parent")
 		useStdin, _ := cmd.Flags().GetBool("stdin")
-		output := viper.GetString("output")
 
 		if spaceKey == "" {
 			return fmt.Errorf("--space/-s flag is required")
@@ -50,15 +49,14 @@ Examples:
 			return fmt.Errorf("failed to create page: %w", err)
 		}
 
-		if output == "json" {
-			data, _ := json.MarshalIndent(page, "", "  ")
-			fmt.Println(string(data))
-		} else {
-			fmt.Printf("Page created successfully!\n")
-			fmt.Printf("ID: %s\n", page.ID)
-			fmt.Printf("Title: %s\n", page.Title)
-			fmt.Printf("URL: %s%s\n", viper.GetString("confluence_base_url"), page.Links.WebUI)
+		if output.Structured() {
+			return output.Print(page)
 		}
+
+		fmt.Printf("Page created successfully!\n")
+		fmt.Printf("ID: %s\n", page.ID)
+		fmt.Printf("Title: %s\n", page.Title)
+		fmt.Printf("URL: %s%s\n", viper.GetString("confluence_base_url"), page.Links.WebUI)
 		return nil
 	},
 }
@@ -1,13 +1,12 @@
 package confluence
 
 import (
-	"encoding/json"
 	"fmt"
-	"strings"
+	"strconv"
 
 	"github.com/joselrodrigues/atlassian/internal/confluence"
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 var getCmd = &cobra.Command{
@@ -18,7 +17,6 @@ var getCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		pageID := args[0]
 		bodyFormat, _ := cmd.Flags().GetString("body-format")
-		output := viper.GetString("output")
 
 		expand := []string{"version", "space"}
 		if bodyFormat != "" {
@@ -31,8 +29,7 @@ var getCmd = &cobra.Command{
 			return fmt.Errorf("failed to get page: %w", err)
 		}
 
-		printPage(page, output, bodyFormat)
-		return nil
+		return printPage(page, bodyFormat)
 	},
 }
 
@@ -41,36 +38,32 @@ func init() {
 	getCmd.Flags().String("body-format", "", "Include body content: storage, view")
 }
 
-func printPage(page *confluence.Page, format string, bodyFormat string) {
-	if format == "json" {
-		data, _ := json.MarshalIndent(page, "", "  ")
-		fmt.Println(string(data))
-		return
+func printPage(page *confluence.Page, bodyFormat string) error {
+	if output.Structured() {
+		return output.Print(page)
 	}
 
-	fmt.Printf("| %-12s | %-60s |\n", "Field", "Value")
-	fmt.Printf("| %-12s | %-60s |\n", "------------", "------------------------------------------------------------")
-	fmt.Printf("| %-12s | %-60s |\n", "ID", page.ID)
-	fmt.Printf("| %-12s | %-60s |\n", "Title", truncate(page.Title, 60))
-	fmt.Printf("| %-12s | %-60s |\n", "Type", page.Type)
-	fmt.Printf("| %-12s | %-60s |\n", "Status", page.Status)
+	table := output.NewTable("Field", "Value")
+	table.AddRow("ID", page.ID)
+	table.AddRow("Title", page.Title)
+	table.AddRow("Type", page.Type)
+	table.AddRow("Status", page.Status)
 
 	if page.Space != nil {
-		fmt.Printf("| %-12s | %-60s |\n", "Space", page.Space.Key+" - "+page.Space.Name)
+		table.AddRow("Space", page.Space.Key+" - "+page.Space.Name)
 	}
 
 	if page.Version != nil {
-		fmt.Printf("| %-12s | %-60d |\n", "Version", page.Version.Number)
+		table.AddRow("Version", strconv.Itoa(page.Version.Number))
 		if page.Version.By != nil {
-			fmt.Printf("| %-12s | %-60s |\n", "Author", page.Version.By.DisplayName)
+			table.AddRow("Author", page.Version.By.DisplayName)
 		}
-		fmt.Printf("| %-12s | %-60s |\n", "Updated", page.Version.When)
+		table.AddRow("Updated", page.Version.When)
 	}
 
-	fmt.Printf("| %-12s | %-60s |\n", "Web URL", page.Links.WebUI)
+	table.AddRow("Web URL", page.Links.WebUI)
 
 	if bodyFormat != "" && page.Body != nil {
-		fmt.Println("\n--- Body Content ---")
 		var content string
 		if bodyFormat == "storage" && page.Body.Storage != nil {
 			content = page.Body.Storage.Value
@@ -78,15 +71,8 @@ func printPage(page *confluence.Page, format string, bodyFormat string) {
 			content = page.Body.View.Value
 		}
 		if content != "" {
-			fmt.Println(content)
+			table.AddSection("Body Content", content)
 		}
 	}
-}
-
-func truncate(s string, max int) string {
-	s = strings.ReplaceAll(s, "\n", " ")
-	if len(s) > max {
-		return s[:max-3] + "..."
-	}
-	return s
+	return table.Print()
 }
@@ -1,12 +1,11 @@
 package confluence
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/joselrodrigues/atlassian/internal/confluence"
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 var pagesCmd = &cobra.Command{
@@ -16,7 +15,6 @@ var pagesCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		spaceKey, _ := cmd.Flags().GetString("space")
 		limit, _ := cmd.Flags().GetInt("limit")
-		output := viper.GetString("output")
 
 		if spaceKey == "" {
 			return fmt.Errorf("--space/-s flag is required")
@@ -28,8 +26,7 @@ var pagesCmd = &cobra.Command{
 			return fmt.Errorf("failed to list pages: %w", err)
 		}
 
-		printPages(pages, output)
-		return nil
+		return printPages(pages)
 	},
 }
 
@@ -39,21 +36,15 @@ func init() {
 	pagesCmd.Flags().Int("limit", 25, "Maximum number of pages to return")
 }
 
-func printPages(pages *confluence.PageResults, format string) {
-	if format == "json" {
-		data, _ := json.MarshalIndent(pages, "", "  ")
-		fmt.Println(string(data))
-		return
+func printPages(pages *confluence.PageResults) error {
+	if output.Structured() {
+		return output.PrintList(pages, pages.Results)
 	}
 
-	fmt.Printf("| %-12s | %-60s |\n", "ID", "Title")
-	fmt.Printf("| %-12s | %-60s |\n", "------------", "------------------------------------------------------------")
+	table := output.NewTable("ID", "Title")
 	for _, p := range pages.Results {
-		title := p.Title
-		if len(title) > 60 {
-			title = title[:57] + "..."
-		}
-		fmt.Printf("| %-12s | %-60s |\n", p.ID, title)
+		table.AddRow(p.ID, p.Title)
 	}
-	fmt.Printf("\nTotal: %d pages\n", pages.Size)
+	table.Footer = fmt.Sprintf("Total: %d pages", pages.Size)
+	return table.Print()
 }
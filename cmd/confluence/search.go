@@ -1,12 +1,11 @@
 package confluence
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/joselrodrigues/atlassian/internal/confluence"
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 var searchCmd = &cobra.Command{
@@ -22,7 +21,6 @@ Examples:
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cql := args[0]
 		limit, _ := cmd.Flags().GetInt("limit")
-		output := viper.GetString("output")
 
 		client := confluence.NewClient()
 		results, err := client.SearchContent(cql, []string{"space", "version"}, limit)
@@ -30,8 +28,7 @@ Examples:
 			return fmt.Errorf("failed to search: %w", err)
 		}
 
-		printSearchResults(results, output)
-		return nil
+		return printSearchResults(results)
 	},
 }
 
@@ -40,25 +37,19 @@ func init() {
 	searchCmd.Flags().Int("limit", 25, "Maximum number of results to return")
 }
 
-func printSearchResults(results *confluence.SearchResponse, format string) {
-	if format == "json" {
-		data, _ := json.MarshalIndent(results, "", "  ")
-		fmt.Println(string(data))
-		return
+func printSearchResults(results *confluence.SearchResponse) error {
+	if output.Structured() {
+		return output.PrintList(results, results.Results)
 	}
 
-	fmt.Printf("| %-12s | %-10s | %-50s |\n", "ID", "Space", "Title")
-	fmt.Printf("| %-12s | %-10s | %-50s |\n", "------------", "----------", "--------------------------------------------------")
+	table := output.NewTable("ID", "Space", "Title")
 	for _, p := range results.Results {
-		title := p.Title
-		if len(title) > 50 {
-			title = title[:47] + "..."
-		}
 		spaceKey := ""
 		if p.Space != nil {
 			spaceKey = p.Space.Key
 		}
-		fmt.Printf("| %-12s | %-10s | %-50s |\n", p.ID, spaceKey, title)
+		table.AddRow(p.ID, spaceKey, p.Title)
 	}
-	fmt.Printf("\nFound: %d results\n", results.Size)
+	table.Footer = fmt.Sprintf("Found: %d results", results.Size)
+	return table.Print()
 }
@@ -1,12 +1,12 @@
 package confluence
 
 import (
-	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/joselrodrigues/atlassian/internal/confluence"
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 var spacesCmd = &cobra.Command{
@@ -16,23 +16,21 @@ var spacesCmd = &cobra.Command{
 	Args:  cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		client := confluence.NewClient()
-		output := viper.GetString("output")
 
 		if len(args) == 1 {
 			space, err := client.GetSpace(args[0])
 			if err != nil {
 				return fmt.Errorf("failed to get space: %w", err)
 			}
-			printSpace(space, output)
-		} else {
-			limit, _ := cmd.Flags().GetInt("limit")
-			spaces, err := client.ListSpaces(limit)
-			if err != nil {
-				return fmt.Errorf("failed to list spaces: %w", err)
-			}
-			printSpaces(spaces, output)
+			return printSpace(space)
 		}
-		return nil
+
+		limit, _ := cmd.Flags().GetInt("limit")
+		spaces, err := client.ListSpaces(limit)
+		if err != nil {
+			return fmt.Errorf("failed to list spaces: %w", err)
+		}
+		return printSpaces(spaces)
 	},
 }
 
@@ -41,37 +39,29 @@ func init() {
 	spacesCmd.Flags().Int("limit", 25, "Maximum number of spaces to return")
 }
 
-func printSpace(space *confluence.Space, format string) {
-	if format == "json" {
-		data, _ := json.MarshalIndent(space, "", "  ")
-		fmt.Println(string(data))
-		return
+func printSpace(space *confluence.Space) error {
+	if output.Structured() {
+		return output.Print(space)
 	}
 
-	fmt.Printf("| %-12s | %-50s |\n", "Field", "Value")
-	fmt.Printf("| %-12s | %-50s |\n", "------------", "--------------------------------------------------")
-	fmt.Printf("| %-12s | %-50d |\n", "ID", space.ID)
-	fmt.Printf("| %-12s | %-50s |\n", "Key", space.Key)
-	fmt.Printf("| %-12s | %-50s |\n", "Name", space.Name)
-	fmt.Printf("| %-12s | %-50s |\n", "Status", space.Status)
-	fmt.Printf("| %-12s | %-50s |\n", "Type", space.Type)
+	table := output.NewTable("Field", "Value")
+	table.AddRow("ID", strconv.Itoa(space.ID))
+	table.AddRow("Key", space.Key)
+	table.AddRow("Name", space.Name)
+	table.AddRow("Status", space.Status)
+	table.AddRow("Type", space.Type)
+	return table.Print()
 }
 
-func printSpaces(spaces *confluence.SpacesResponse, format string) {
-	if format == "json" {
-		data, _ := json.MarshalIndent(spaces, "", "  ")
-		fmt.Println(string(data))
-		return
+func printSpaces(spaces *confluence.SpacesResponse) error {
+	if output.Structured() {
+		return output.PrintList(spaces, spaces.Results)
 	}
 
-	fmt.Printf("| %-10s | %-40s | %-10s |\n", "Key", "Name", "Type")
-	fmt.Printf("| %-10s | %-40s | %-10s |\n", "----------", "----------------------------------------", "----------")
+	table := output.NewTable("Key", "Name", "Type")
 	for _, s := range spaces.Results {
-		name := s.Name
-		if len(name) > 40 {
-			name = name[:37] + "..."
-		}
-		fmt.Printf("| %-10s | %-40s | %-10s |\n", s.Key, name, s.Type)
+		table.AddRow(s.Key, s.Name, s.Type)
 	}
-	fmt.Printf("\nTotal: %d spaces\n", spaces.Size)
+	table.Footer = fmt.Sprintf("Total: %d spaces", spaces.Size)
+	return table.Print()
 }
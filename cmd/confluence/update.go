@@ -1,10 +1,10 @@
 package confluence
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/joselrodrigues/atlassian/internal/confluence"
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -24,7 +24,6 @@ Examples:
 		title, _ := cmd.Flags().GetString("title")
 		message, _ := cmd.Flags().GetString("message")
 		useStdin, _ := cmd.Flags().GetBool("stdin")
-		output := viper.GetString("output")
 
 		client := confluence.NewClient()
 
@@ -59,16 +58,15 @@ Examples:
 			return fmt.Errorf("failed to update page: %w", err)
 		}
 
-		if output == "json" {
-			data, _ := json.MarshalIndent(page, "", "  ")
-			fmt.Println(string(data))
-		} else {
-			fmt.Printf("Page updated successfully!\n")
-			fmt.Printf("ID: %s\n", page.ID)
-			fmt.Printf("Title: %s\n", page.Title)
-			fmt.Printf("Version: %d\n", page.Version.Number)
-			fmt.Printf("URL: %s%s\n", viper.GetString("confluence_base_url"), page.Links.WebUI)
+		if output.Structured() {
+			return output.Print(page)
 		}
+
+		fmt.Printf("Page updated successfully!\n")
+		fmt.Printf("ID: %s\n", page.ID)
+		fmt.Printf("Title: %s\n", page.Title)
+		fmt.Printf("Version: %d\n", page.Version.Number)
+		fmt.Printf("URL: %s%s\n", viper.GetString("confluence_base_url"), page.Links.WebUI)
 		return nil
 	},
 }
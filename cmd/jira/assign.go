@@ -11,10 +11,10 @@ import (
 )
 
 var assignCmd = &cobra.Command{
-	Use:   "assign [issue-key] [email-or-accountId]",
+	Use:   "assign [issue-key] [user]",
 	Short: "Assign a user to an issue",
-	Long: `Assign a user to an issue using email or accountId.
-If the input contains '@', it's treated as an email and the accountId is looked up.
+	Long: `Assign a user to an issue using an email, display name, username, accountId or "me".
+Ambiguous matches are listed (or prompted for when running in a terminal).
 Use --unassign to remove the current assignee.`,
 	Args: cobra.RangeArgs(1, 2),
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -38,26 +38,12 @@ Use --unassign to remove the current assignee.`,
 		}
 
 		if len(args) < 2 {
-			return fmt.Errorf("user email or accountId is required (or use --unassign)")
+			return fmt.Errorf("user is required (or use --unassign)")
 		}
 
-		userInput := args[1]
-		var userID string
-
-		if strings.Contains(userInput, "@") {
-			users, err := client.SearchUsers(userInput)
-			if err != nil {
-				return fmt.Errorf("failed to search for user: %w", err)
-			}
-
-			if len(users) == 0 {
-				return fmt.Errorf("no user found with email: %s", userInput)
-			}
-
-			userID = users[0].GetIdentifier(client.IsCloud())
-			fmt.Printf("Found user: %s (%s)\n", users[0].DisplayName, userID)
-		} else {
-			userID = userInput
+		userID, err := resolveAssigneeID(client, issueKey, args[1])
+		if err != nil {
+			return err
 		}
 
 		if err := client.AssignIssue(issueKey, userID); err != nil {
@@ -1,12 +1,12 @@
 package jira
 
 import (
-	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 var boardsCmd = &cobra.Command{
@@ -15,7 +15,6 @@ var boardsCmd = &cobra.Command{
 	Long:  `List all Jira boards, optionally filtered by project.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		project, _ := cmd.Flags().GetString("project")
-		output := viper.GetString("output")
 
 		client := jira.NewClient()
 		result, err := client.GetBoards(project)
@@ -23,31 +22,16 @@ var boardsCmd = &cobra.Command{
 			return fmt.Errorf("failed to get boards: %w", err)
 		}
 
-		if output == "json" {
-			jsonData, err := json.MarshalIndent(result.Values, "", "  ")
-			if err != nil {
-				return fmt.Errorf("failed to marshal JSON: %w", err)
-			}
-			fmt.Println(string(jsonData))
-			return nil
+		if output.Structured() {
+			return output.Print(result.Values)
 		}
 
-		if len(result.Values) == 0 {
-			fmt.Println("No boards found")
-			return nil
-		}
-
-		fmt.Println("| Board ID | Name | Type | Project |")
-		fmt.Println("| -------- | ---- | ---- | ------- |")
+		table := output.NewTable("Board ID", "Name", "Type", "Project")
+		table.Empty = "No boards found"
 		for _, board := range result.Values {
-			projectKey := board.Location.ProjectKey
-			if projectKey == "" {
-				projectKey = "-"
-			}
-			fmt.Printf("| %d | %s | %s | %s |\n", board.ID, board.Name, board.Type, projectKey)
+			table.AddRow(strconv.Itoa(board.ID), board.Name, board.Type, valueOrDash(board.Location.ProjectKey))
 		}
-
-		return nil
+		return table.Print()
 	},
 }
 
@@ -0,0 +1,224 @@
+package jira
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var bulkCmd = &cobra.Command{
+	Use:   "bulk",
+	Short: "Apply changes to every issue matching a JQL query",
+	Long: `Apply field changes, an assignment, a comment and/or a transition to every issue
+matching a JQL query.
+
+The issue set is resolved first and previewed, and the changes are only applied after
+confirmation (or with --yes). Issues are processed concurrently with a bounded number of
+workers and a shared request rate limit. A per-issue report is printed at the end; with
+-o json the summary includes the failed keys and a retry JQL.
+
+--set accepts field=value, field+=value (add to a multi-value field) and field-=value
+(remove from a multi-value field). Fields can be given by ID or name.
+
+Examples:
+  atlassian jira bulk --jql "project = PROJ AND labels = needs-triage" --set labels+=triaged --set labels-=needs-triage
+  atlassian jira bulk --jql "sprint in openSprints() AND status = Review" --transition Done --comment "Closed in bulk"
+  atlassian jira bulk --jql "project = PROJ AND assignee is EMPTY" --assign me --yes -o json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		jql, _ := cmd.Flags().GetString("jql")
+		transition, _ := cmd.Flags().GetString("transition")
+		assignee, _ := cmd.Flags().GetString("assign")
+		sets, _ := cmd.Flags().GetStringArray("set")
+		comment, _ := cmd.Flags().GetString("comment")
+		yes, _ := cmd.Flags().GetBool("yes")
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+		rate, _ := cmd.Flags().GetFloat64("rate")
+
+		if jql == "" {
+			return fmt.Errorf("--jql is required")
+		}
+		if transition == "" && assignee == "" && len(sets) == 0 && comment == "" {
+			return fmt.Errorf("at least one of --transition, --assign, --set or --comment is required")
+		}
+
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+
+		var changes []jira.FieldChange
+		for _, expr := range sets {
+			name, op, value, err := jira.ParseFieldChange(expr)
+			if err != nil {
+				return err
+			}
+			field, err := client.FindField(name)
+			if err != nil {
+				return err
+			}
+			changes = append(changes, jira.FieldChange{Field: field, Op: op, Value: value})
+		}
+		fields, update, err := client.ApplyFieldChanges(changes)
+		if err != nil {
+			return err
+		}
+
+		var userID string
+		if assignee != "" {
+			userID, err = resolveUserID(client, assignee)
+			if err != nil {
+				return err
+			}
+		}
+
+		issues, err := client.SearchAllIssues(jql, []string{"summary", "status"}, nil)
+		if err != nil {
+			return fmt.Errorf("search failed: %w", err)
+		}
+		if len(issues) == 0 {
+			fmt.Println("No issues found")
+			return nil
+		}
+
+		printBulkPreview(issues, sets, userID, comment, transition)
+		if !yes {
+			if !isTerminal() {
+				return fmt.Errorf("refusing to modify %d issues without confirmation (use --yes)", len(issues))
+			}
+			if !confirm(fmt.Sprintf("Apply changes to %d issues?", len(issues))) {
+				return fmt.Errorf("aborted")
+			}
+		}
+
+		keys := make([]string, len(issues))
+		for i, issue := range issues {
+			keys[i] = issue.Key
+		}
+
+		client.SetRateLimit(rate)
+		defer client.SetRateLimit(0)
+		var mu sync.Mutex
+		done := 0
+		results := jira.RunBulk(keys, concurrency, func(key string) error {
+			err := applyBulk(client, key, fields, update, userID, comment, transition)
+
+			mu.Lock()
+			done++
+			status := "ok"
+			if err != nil {
+				status = "FAILED: " + err.Error()
+			}
+			fmt.Fprintf(os.Stderr, "[%d/%d] %s %s\n", done, len(keys), key, status)
+			mu.Unlock()
+
+			return err
+		})
+
+		summary := jira.SummarizeBulk(results)
+		if output.Structured() {
+			if err := output.PrintList(summary, summary.Results); err != nil {
+				return err
+			}
+		} else {
+			table := output.NewTable("Key", "Result", "Error")
+			for _, r := range summary.Results {
+				result := "OK"
+				if !r.Success {
+					result = "FAILED"
+				}
+				table.AddRow(r.Key, result, valueOrDash(r.Error))
+			}
+			if output.Human() {
+				fmt.Println()
+				table.Footer = fmt.Sprintf("%d succeeded, %d failed", summary.Succeeded, summary.Failed)
+				if summary.RetryJQL != "" {
+					table.Footer += fmt.Sprintf("\nRetry with: --jql %q", summary.RetryJQL)
+				}
+			}
+			if err := table.Print(); err != nil {
+				return err
+			}
+		}
+
+		if summary.Failed > 0 {
+			return fmt.Errorf("%d of %d issues failed", summary.Failed, summary.Total)
+		}
+		return nil
+	},
+}
+
+func init() {
+	Cmd.AddCommand(bulkCmd)
+
+	bulkCmd.Flags().String("jql", "", "JQL query selecting the issues (required)")
+	bulkCmd.Flags().String("transition", "", "Transition name or ID to apply")
+	bulkCmd.Flags().String("assign", "", "Assign to user (email, name, username, accountId or 'me')")
+	bulkCmd.Flags().StringArray("set", nil, "Field change: field=value, field+=value or field-=value (repeatable)")
+	bulkCmd.Flags().String("comment", "", "Comment to add")
+	bulkCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	bulkCmd.Flags().Int("concurrency", 4, "Number of issues processed in parallel")
+	bulkCmd.Flags().Float64("rate", 5, "Maximum API requests per second (0 for unlimited)")
+}
+
+func applyBulk(client *jira.Client, key string, fields, update map[string]interface{}, userID, comment, transition string) error {
+	if len(fields) > 0 || len(update) > 0 {
+		if err := client.EditIssue(key, fields, update); err != nil {
+			return fmt.Errorf("update: %w", err)
+		}
+	}
+	if userID != "" {
+		if err := client.AssignIssue(key, userID); err != nil {
+			return fmt.Errorf("assign: %w", err)
+		}
+	}
+	if comment != "" {
+		if _, err := client.AddComment(key, comment); err != nil {
+			return fmt.Errorf("comment: %w", err)
+		}
+	}
+	if transition != "" {
+		if err := client.DoTransition(key, transition); err != nil {
+			return fmt.Errorf("transition: %w", err)
+		}
+	}
+	return nil
+}
+
+func printBulkPreview(issues []jira.Issue, sets []string, userID, comment, transition string) {
+	fmt.Fprintf(os.Stderr, "%d issues match:\n\n", len(issues))
+	for i, issue := range issues {
+		if i == 20 {
+			fmt.Fprintf(os.Stderr, "  ... and %d more\n", len(issues)-20)
+			break
+		}
+		fmt.Fprintf(os.Stderr, "  %s [%s] %s\n", issue.Key, issue.Fields.Status.Name, issue.Fields.Summary)
+	}
+
+	fmt.Fprintf(os.Stderr, "\nChanges:\n")
+	for _, s := range sets {
+		fmt.Fprintf(os.Stderr, "  set %s\n", s)
+	}
+	if userID != "" {
+		fmt.Fprintf(os.Stderr, "  assign to %s\n", userID)
+	}
+	if comment != "" {
+		fmt.Fprintf(os.Stderr, "  comment %q\n", comment)
+	}
+	if transition != "" {
+		fmt.Fprintf(os.Stderr, "  transition to %s\n", transition)
+	}
+	fmt.Fprintln(os.Stderr)
+}
+
+func confirm(prompt string) bool {
+	fmt.Fprintf(os.Stderr, "%s [y/N]: ", prompt)
+	line, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer := strings.ToLower(strings.TrimSpace(line))
+	return answer == "y" || answer == "yes"
+}
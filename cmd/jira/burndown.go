@@ -0,0 +1,244 @@
+package jira
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var reportBurndownCmd = &cobra.Command{
+	Use:   "burndown [sprint-id]",
+	Short: "Burndown/burnup chart for a sprint",
+	Long: `Reconstruct the remaining story points per day of a sprint from issue changelogs
+(status changes to a done category, story point changes, scope added or removed) and
+render it as a chart in the terminal.
+
+Use --format csv, json or svg to export the chart data.
+
+Examples:
+  atlassian jira report burndown 345
+  atlassian jira report burndown 345 --burnup
+  atlassian jira report burndown 345 --format svg > burndown.svg`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		sprintID, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid sprint ID: %s", args[0])
+		}
+		pointsField, _ := cmd.Flags().GetString("points-field")
+		format, _ := cmd.Flags().GetString("format")
+		burnup, _ := cmd.Flags().GetBool("burnup")
+		ascii, _ := cmd.Flags().GetBool("ascii")
+		height, _ := cmd.Flags().GetInt("height")
+		if format == "" {
+			format = output.Format()
+		}
+
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+		field, err := client.FindField(pointsField)
+		if err != nil {
+			return fmt.Errorf("failed to resolve story points field: %w", err)
+		}
+
+		sprint, err := client.GetSprint(sprintID)
+		if err != nil {
+			return fmt.Errorf("failed to get sprint: %w", err)
+		}
+
+		burndown, err := client.GetBurndown(sprint, jira.ReportOptions{
+			PointsField:    field,
+			IncludeRemoved: true,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to compute burndown: %w", err)
+		}
+
+		switch format {
+		case "svg":
+			fmt.Print(renderBurndownSVG(burndown, burnup))
+			return nil
+		case "text", "markdown":
+			title := "Burndown"
+			if burnup {
+				title = "Burnup"
+			}
+			fmt.Printf("## %s: %s (%s)\n\n", title, burndown.Sprint.Name, burndown.Sprint.State)
+			fmt.Print(renderBurndownChart(burndown.Days, burnup, ascii, height))
+			return nil
+		case "csv", "tsv":
+			table := output.NewTable("Date", "Ideal", "Remaining", "Completed", "Scope")
+			for _, day := range burndown.Days {
+				table.AddRow(
+					day.Date,
+					formatPoints(day.Ideal),
+					optionalPoints(day.Remaining),
+					optionalPoints(day.Completed),
+					optionalPoints(day.Scope),
+				)
+			}
+			return table.Write(os.Stdout, format)
+		}
+		return output.PrintListAs(format, burndown, burndown.Days)
+	},
+}
+
+func init() {
+	reportCmd.AddCommand(reportBurndownCmd)
+
+	reportBurndownCmd.Flags().String("format", "", "Output format: svg or any --output format (defaults to --output)")
+	reportBurndownCmd.Flags().Bool("burnup", false, "Render completed vs scope instead of remaining points")
+	reportBurndownCmd.Flags().Bool("ascii", false, "Use plain ASCII characters for the terminal chart")
+	reportBurndownCmd.Flags().Int("height", 15, "Terminal chart height in rows")
+}
+
+func optionalPoints(points *float64) string {
+	if points == nil {
+		return ""
+	}
+	return formatPoints(*points)
+}
+
+func burndownSeries(days []jira.BurndownDay, burnup bool) ([]*float64, []*float64) {
+	bars := make([]*float64, len(days))
+	lines := make([]*float64, len(days))
+	for i := range days {
+		if burnup {
+			bars[i] = days[i].Completed
+			lines[i] = days[i].Scope
+		} else {
+			bars[i] = days[i].Remaining
+			lines[i] = &days[i].Ideal
+		}
+	}
+	return bars, lines
+}
+
+func seriesMax(series ...[]*float64) float64 {
+	var max float64
+	for _, s := range series {
+		for _, v := range s {
+			if v != nil && *v > max {
+				max = *v
+			}
+		}
+	}
+	return max
+}
+
+func renderBurndownChart(days []jira.BurndownDay, burnup, ascii bool, height int) string {
+	bars, lines := burndownSeries(days, burnup)
+	max := seriesMax(bars, lines)
+	if len(days) == 0 || max == 0 {
+		return "No story points in sprint\n"
+	}
+	if height < 2 {
+		height = 2
+	}
+
+	barCell, lineCell, axisY, corner, axisX := "██ ", "·· ", "┤", "└", "───"
+	if ascii {
+		barCell, lineCell, axisY, corner, axisX = "## ", ".. ", "|", "+", "---"
+	}
+
+	step := max / float64(height)
+	var sb strings.Builder
+	for row := height; row >= 1; row-- {
+		threshold := step * float64(row)
+		label := ""
+		if row == height || row%5 == 0 {
+			label = formatPoints(float64(int(threshold + 0.5)))
+		}
+		fmt.Fprintf(&sb, "%6s %s", label, axisY)
+		for i := range days {
+			switch {
+			case bars[i] != nil && *bars[i] >= threshold-step/2:
+				sb.WriteString(barCell)
+			case lines[i] != nil && *lines[i] > threshold-step && *lines[i] <= threshold:
+				sb.WriteString(lineCell)
+			default:
+				sb.WriteString("   ")
+			}
+		}
+		sb.WriteString("\n")
+	}
+
+	fmt.Fprintf(&sb, "%6s %s%s\n", "0", corner, strings.Repeat(axisX, len(days)))
+	fmt.Fprintf(&sb, "%6s  ", "")
+	for _, day := range days {
+		fmt.Fprintf(&sb, "%-3s", day.Date[8:])
+	}
+	sb.WriteString("\n\n")
+
+	if burnup {
+		fmt.Fprintf(&sb, "%s completed  %s scope\n", strings.TrimSpace(barCell), strings.TrimSpace(lineCell))
+	} else {
+		fmt.Fprintf(&sb, "%s remaining  %s ideal\n", strings.TrimSpace(barCell), strings.TrimSpace(lineCell))
+	}
+	return sb.String()
+}
+
+func renderBurndownSVG(burndown *jira.Burndown, burnup bool) string {
+	const width, height, margin = 800.0, 400.0, 50.0
+
+	days := burndown.Days
+	bars, lines := burndownSeries(days, burnup)
+	max := seriesMax(bars, lines)
+	if max == 0 {
+		max = 1
+	}
+
+	x := func(i int) float64 {
+		if len(days) < 2 {
+			return margin
+		}
+		return margin + float64(i)*(width-2*margin)/float64(len(days)-1)
+	}
+	y := func(v float64) float64 {
+		return height - margin - v*(height-2*margin)/max
+	}
+	polyline := func(series []*float64, style string) string {
+		var points []string
+		for i, v := range series {
+			if v != nil {
+				points = append(points, fmt.Sprintf("%.1f,%.1f", x(i), y(*v)))
+			}
+		}
+		return fmt.Sprintf("  <polyline fill=\"none\" %s points=\"%s\"/>\n", style, strings.Join(points, " "))
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%.0f\" height=\"%.0f\" font-family=\"sans-serif\" font-size=\"11\">\n", width, height)
+	fmt.Fprintf(&sb, "  <rect width=\"100%%\" height=\"100%%\" fill=\"white\"/>\n")
+	fmt.Fprintf(&sb, "  <text x=\"%.0f\" y=\"25\" font-size=\"14\">%s</text>\n", margin, html.EscapeString(burndown.Sprint.Name))
+	fmt.Fprintf(&sb, "  <line x1=\"%.0f\" y1=\"%.0f\" x2=\"%.0f\" y2=\"%.0f\" stroke=\"black\"/>\n", margin, margin, margin, height-margin)
+	fmt.Fprintf(&sb, "  <line x1=\"%.0f\" y1=\"%.0f\" x2=\"%.0f\" y2=\"%.0f\" stroke=\"black\"/>\n", margin, height-margin, width-margin, height-margin)
+
+	for _, v := range []float64{0, max / 2, max} {
+		fmt.Fprintf(&sb, "  <text x=\"%.0f\" y=\"%.1f\" text-anchor=\"end\">%s</text>\n", margin-5, y(v)+4, formatPoints(float64(int(v+0.5))))
+	}
+	labelEvery := len(days)/10 + 1
+	for i, day := range days {
+		if i%labelEvery == 0 {
+			fmt.Fprintf(&sb, "  <text x=\"%.1f\" y=\"%.0f\" text-anchor=\"middle\">%s</text>\n", x(i), height-margin+15, day.Date[5:])
+		}
+	}
+
+	if burnup {
+		sb.WriteString(polyline(lines, `stroke="#1f77b4" stroke-width="2"`))
+		sb.WriteString(polyline(bars, `stroke="#2ca02c" stroke-width="2"`))
+	} else {
+		sb.WriteString(polyline(lines, `stroke="#999999" stroke-dasharray="4,4"`))
+		sb.WriteString(polyline(bars, `stroke="#d62728" stroke-width="2"`))
+	}
+	sb.WriteString("</svg>\n")
+	return sb.String()
+}
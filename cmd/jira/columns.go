@@ -0,0 +1,94 @@
+package jira
+
+import (
+	"fmt"
+
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
+	"github.com/spf13/cobra"
+)
+
+func issueFields(columns []jira.Column) []string {
+	if output.Uses("field") {
+		return []string{"*all"}
+	}
+	return jira.ColumnFields(columns)
+}
+
+func issueColumns(cmd *cobra.Command, client *jira.Client) ([]jira.Column, error) {
+	names, _ := cmd.Flags().GetStringSlice("columns")
+	if len(names) == 0 {
+		return nil, nil
+	}
+	return client.ResolveColumns(names)
+}
+
+func issueTable(columns []jira.Column, issues []jira.Issue) *output.Table {
+	if len(columns) == 0 {
+		table := output.NewTable("Key", "Status", "SP", "Assignee", "Summary")
+		for _, issue := range issues {
+			assignee := "Unassigned"
+			if issue.Fields.Assignee != nil {
+				assignee = issue.Fields.Assignee.DisplayName
+			}
+
+			sp := "-"
+			if issue.Fields.StoryPoints > 0 {
+				sp = fmt.Sprintf("%.0f", issue.Fields.StoryPoints)
+			}
+
+			table.AddRow(issue.Key, issue.Fields.Status.Name, sp, assignee, issue.Fields.Summary)
+		}
+		return table
+	}
+
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = col.Name
+	}
+
+	table := output.NewTable(header...)
+	for _, issue := range issues {
+		row := make([]string, len(columns))
+		for i, col := range columns {
+			row[i] = valueOrDash(col.Value(issue))
+		}
+		table.AddRow(row...)
+	}
+	return table
+}
+
+var templateColumns = make(map[string]jira.Column)
+
+func templateField(name string, v interface{}) (string, error) {
+	var issue jira.Issue
+	switch i := v.(type) {
+	case jira.Issue:
+		issue = i
+	case *jira.Issue:
+		if i == nil {
+			return "", nil
+		}
+		issue = *i
+	default:
+		return "", fmt.Errorf("field expects an issue, got %T", v)
+	}
+
+	col, ok := templateColumns[name]
+	if !ok {
+		columns, err := jira.NewClient().ResolveColumns([]string{name})
+		if err != nil {
+			return "", err
+		}
+		if len(columns) == 0 {
+			return "", fmt.Errorf("field name is required")
+		}
+		col = columns[0]
+		templateColumns[name] = col
+	}
+	return col.Value(issue), nil
+}
+
+func init() {
+	output.RegisterFunc("field", templateField)
+}
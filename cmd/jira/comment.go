@@ -1,12 +1,11 @@
 package jira
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 var commentCmd = &cobra.Command{
@@ -28,16 +27,22 @@ var commentListCmd = &cobra.Command{
 			return fmt.Errorf("failed to get comments: %w", err)
 		}
 
-		if viper.GetString("output") == "json" {
-			data, _ := json.MarshalIndent(comments, "", "  ")
-			fmt.Println(string(data))
-			return nil
+		if output.Structured() {
+			return output.PrintList(comments, comments.Comments)
+		}
+
+		if !output.Human() {
+			table := output.NewTable("ID", "Author", "Created", "Body")
+			for _, c := range comments.Comments {
+				table.AddRow(c.ID, c.Author.DisplayName, c.Created, c.Body)
+			}
+			return table.Print()
 		}
 
 		fmt.Printf("Comments on %s (%d total):\n\n", issueKey, comments.Total)
 		for _, c := range comments.Comments {
 			fmt.Printf("---\n")
-			fmt.Printf("**%s** (%s)\n", c.Author.DisplayName, c.Created[:10])
+			fmt.Printf("**%s** (%s)\n", c.Author.DisplayName, shortDate(c.Created))
 			fmt.Printf("%s\n\n", c.Body)
 		}
 
@@ -59,10 +64,8 @@ var commentAddCmd = &cobra.Command{
 			return fmt.Errorf("failed to add comment: %w", err)
 		}
 
-		if viper.GetString("output") == "json" {
-			data, _ := json.MarshalIndent(comment, "", "  ")
-			fmt.Println(string(data))
-			return nil
+		if output.Structured() {
+			return output.Print(comment)
 		}
 
 		fmt.Printf("Comment added to %s (ID: %s)\n", issueKey, comment.ID)
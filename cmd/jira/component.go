@@ -0,0 +1,209 @@
+package jira
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var componentsCmd = &cobra.Command{
+	Use:   "components",
+	Short: "List project components",
+	Long:  `List the components of a project with their lead and default assignee.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		project, err := projectFlag(cmd)
+		if err != nil {
+			return err
+		}
+
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+
+		components, err := client.GetProjectComponents(project)
+		if err != nil {
+			return fmt.Errorf("failed to get components: %w", err)
+		}
+
+		if output.Structured() {
+			return output.Print(components)
+		}
+
+		table := output.NewTable("ID", "Name", "Lead", "Default Assignee", "Description")
+		table.Empty = "No components found"
+		for _, c := range components {
+			lead := "-"
+			if c.Lead != nil {
+				lead = c.Lead.DisplayName
+			}
+			table.AddRow(c.ID, c.Name, lead, valueOrDash(c.AssigneeType), valueOrDash(c.Description))
+		}
+		return table.Print()
+	},
+}
+
+var componentCmd = &cobra.Command{
+	Use:   "component",
+	Short: "Manage project components",
+	Long: `Create, update and delete project components.
+
+--default-assignee accepts PROJECT_DEFAULT, COMPONENT_LEAD, PROJECT_LEAD or UNASSIGNED.`,
+}
+
+var componentCreateCmd = &cobra.Command{
+	Use:   "create [name]",
+	Short: "Create a component",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		project, err := projectFlag(cmd)
+		if err != nil {
+			return err
+		}
+
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+
+		fields, err := componentFlags(cmd, client, args[0])
+		if err != nil {
+			return err
+		}
+
+		component, err := client.CreateComponent(project, fields)
+		if err != nil {
+			return fmt.Errorf("failed to create component: %w", err)
+		}
+
+		if output.Structured() {
+			return output.Print(component)
+		}
+
+		fmt.Printf("Component %s created in %s (ID: %s)\n", component.Name, project, component.ID)
+		return nil
+	},
+}
+
+var componentUpdateCmd = &cobra.Command{
+	Use:   "update [name-or-id]",
+	Short: "Update a component",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		newName, _ := cmd.Flags().GetString("name")
+
+		project, err := projectFlag(cmd)
+		if err != nil {
+			return err
+		}
+
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+
+		component, err := client.FindComponent(project, args[0])
+		if err != nil {
+			return err
+		}
+
+		fields, err := componentFlags(cmd, client, newName)
+		if err != nil {
+			return err
+		}
+		if len(fields) == 0 {
+			return fmt.Errorf("at least one field must be specified")
+		}
+
+		if _, err := client.UpdateComponent(component.ID, fields); err != nil {
+			return fmt.Errorf("failed to update component: %w", err)
+		}
+
+		if newName == "" {
+			newName = component.Name
+		}
+		fmt.Printf("Component %s updated successfully!\n", newName)
+		return nil
+	},
+}
+
+var componentDeleteCmd = &cobra.Command{
+	Use:   "delete [name-or-id]",
+	Short: "Delete a component",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		moveTo, _ := cmd.Flags().GetString("move-issues-to")
+
+		project, err := projectFlag(cmd)
+		if err != nil {
+			return err
+		}
+
+		client := jira.NewClient()
+		component, err := client.FindComponent(project, args[0])
+		if err != nil {
+			return err
+		}
+
+		var moveToID string
+		if moveTo != "" {
+			target, err := client.FindComponent(project, moveTo)
+			if err != nil {
+				return err
+			}
+			moveToID = target.ID
+		}
+
+		if err := client.DeleteComponent(component.ID, moveToID); err != nil {
+			return fmt.Errorf("failed to delete component: %w", err)
+		}
+
+		fmt.Printf("Component %s deleted\n", component.Name)
+		return nil
+	},
+}
+
+func init() {
+	Cmd.AddCommand(componentsCmd)
+	Cmd.AddCommand(componentCmd)
+	componentCmd.AddCommand(componentCreateCmd)
+	componentCmd.AddCommand(componentUpdateCmd)
+	componentCmd.AddCommand(componentDeleteCmd)
+
+	componentsCmd.Flags().StringP("project", "p", "", "Project key (defaults to the configured project)")
+	componentCmd.PersistentFlags().StringP("project", "p", "", "Project key (defaults to the configured project)")
+
+	for _, c := range []*cobra.Command{componentCreateCmd, componentUpdateCmd} {
+		c.Flags().StringP("description", "d", "", "Component description")
+		c.Flags().String("lead", "", "Component lead (email, name, username, accountId or 'me')")
+		c.Flags().String("default-assignee", "", "Default assignee: PROJECT_DEFAULT, COMPONENT_LEAD, PROJECT_LEAD, UNASSIGNED")
+	}
+	componentUpdateCmd.Flags().String("name", "", "New component name")
+	componentDeleteCmd.Flags().String("move-issues-to", "", "Move issues of the deleted component to this component")
+}
+
+func componentFlags(cmd *cobra.Command, client *jira.Client, name string) (map[string]interface{}, error) {
+	description, _ := cmd.Flags().GetString("description")
+	lead, _ := cmd.Flags().GetString("lead")
+	assigneeType, _ := cmd.Flags().GetString("default-assignee")
+
+	switch assigneeType {
+	case "", "PROJECT_DEFAULT", "COMPONENT_LEAD", "PROJECT_LEAD", "UNASSIGNED":
+	default:
+		return nil, fmt.Errorf("invalid --default-assignee: %s", assigneeType)
+	}
+
+	var leadID string
+	if lead != "" {
+		var err error
+		leadID, err = resolveUserID(client, lead)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return client.ComponentFields(name, description, leadID, assigneeType), nil
+}
@@ -2,12 +2,12 @@ package jira
 
 import (
 	"bufio"
-	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
 
 	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -17,7 +17,6 @@ var createCmd = &cobra.Command{
 	Short: "Create a new issue",
 	Long:  `Create a new Jira issue with the specified project, type, summary, and description.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		project, _ := cmd.Flags().GetString("project")
 		issueType, _ := cmd.Flags().GetString("type")
 		summary, _ := cmd.Flags().GetString("summary")
 		description, _ := cmd.Flags().GetString("description")
@@ -40,16 +39,24 @@ var createCmd = &cobra.Command{
 			return fmt.Errorf("--summary is required")
 		}
 
+		project, err := projectFlag(cmd)
+		if err != nil {
+			return err
+		}
+		if !cmd.Flags().Changed("type") {
+			if defaultType := projectDefault(project, "issue_type"); defaultType != "" {
+				issueType = defaultType
+			}
+		}
+
 		client := jira.NewClient()
 		resp, err := client.CreateIssue(project, issueType, summary, description)
 		if err != nil {
 			return fmt.Errorf("failed to create issue: %w", err)
 		}
 
-		if viper.GetString("output") == "json" {
-			data, _ := json.MarshalIndent(resp, "", "  ")
-			fmt.Println(string(data))
-			return nil
+		if output.Structured() {
+			return output.Print(resp)
 		}
 
 		baseURL := strings.TrimSuffix(viper.GetString("jira_base_url"), "/")
@@ -64,9 +71,8 @@ var createCmd = &cobra.Command{
 func init() {
 	Cmd.AddCommand(createCmd)
 
-	createCmd.Flags().StringP("project", "p", "", "Project key (required)")
-	createCmd.MarkFlagRequired("project")
-	createCmd.Flags().StringP("type", "t", "Story", "Issue type (Story, Bug, Task)")
+	createCmd.Flags().StringP("project", "p", "", "Project key (defaults to the configured project)")
+	createCmd.Flags().StringP("type", "t", "Story", "Issue type (Story, Bug, Task; defaults to the project's default type)")
 	createCmd.Flags().StringP("summary", "s", "", "Issue summary (required)")
 	createCmd.Flags().StringP("description", "d", "", "Issue description")
 	createCmd.Flags().Bool("stdin", false, "Read description from stdin")
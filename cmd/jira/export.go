@@ -0,0 +1,206 @@
+package jira
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var defaultExportFields = []string{"key", "type", "summary", "status", "priority", "assignee", "reporter", "created", "updated"}
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export every issue matching a JQL query to CSV or XLSX",
+	Long: `Export every issue matching a JQL query to a CSV or XLSX file.
+
+The full result set is paged through and written as it arrives, so exports are not
+limited to the 1000 rows of the Jira UI. Custom fields are named after their Jira field
+names and nested values (users, options, versions, sprints) are flattened to their names.
+
+--fields takes field names or IDs, or "all" for every field of the instance. The format
+defaults to the extension of the --output file, or CSV.
+
+Unlike other commands, -o/--output names the file to write rather than the output format.
+
+Examples:
+  atlassian jira export --jql "project = PROJ" -o issues.csv
+  atlassian jira export --jql "project = PROJ AND sprint in openSprints()" --fields all -o sprint.xlsx
+  atlassian jira export --jql "fixVersion = 1.2" --fields key,summary,"Story Points",sprint --format csv > release.csv`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		jql, _ := cmd.Flags().GetString("jql")
+		names, _ := cmd.Flags().GetStringSlice("fields")
+		format, _ := cmd.Flags().GetString("format")
+		path, _ := cmd.Flags().GetString("output")
+
+		if jql == "" {
+			return fmt.Errorf("--jql is required")
+		}
+		for _, f := range output.Formats {
+			if strings.EqualFold(path, f) {
+				return fmt.Errorf("--output is the file to write for export, not an output format (use --format csv or xlsx)")
+			}
+		}
+		if format == "" {
+			format = "csv"
+			if strings.EqualFold(filepath.Ext(path), ".xlsx") {
+				format = "xlsx"
+			}
+		}
+		format = strings.ToLower(format)
+		if format != "csv" && format != "xlsx" {
+			return fmt.Errorf("unsupported export format '%s' (use csv or xlsx)", format)
+		}
+		if format == "xlsx" && path == "" && term.IsTerminal(int(os.Stdout.Fd())) {
+			return fmt.Errorf("refusing to write XLSX to a terminal (use --output)")
+		}
+
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+
+		columns, fields, err := exportColumns(client, names)
+		if err != nil {
+			return err
+		}
+
+		var out io.Writer = os.Stdout
+		var file *os.File
+		if path != "" {
+			file, err = os.Create(path)
+			if err != nil {
+				return fmt.Errorf("failed to create export file: %w", err)
+			}
+			out = file
+		}
+
+		count, err := exportIssues(client, jql, columns, fields, format, out)
+		if file != nil {
+			if closeErr := file.Close(); err == nil && closeErr != nil {
+				err = fmt.Errorf("failed to write export file: %w", closeErr)
+			}
+			if err != nil {
+				os.Remove(path)
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		if path != "" {
+			fmt.Printf("Exported %d issues to %s\n", count, path)
+		}
+		return nil
+	},
+}
+
+func init() {
+	Cmd.AddCommand(exportCmd)
+	exportCmd.Flags().String("jql", "", "JQL query selecting the issues (required)")
+	exportCmd.Flags().StringSlice("fields", defaultExportFields, "Fields to export by name or ID, or \"all\"")
+	exportCmd.Flags().String("format", "", "Export format: csv or xlsx (defaults to the --output extension, or csv)")
+	exportCmd.Flags().StringP("output", "o", "", "File to write (defaults to stdout)")
+}
+
+func exportColumns(client *jira.Client, names []string) ([]jira.Column, []string, error) {
+	if len(names) == 1 && strings.EqualFold(names[0], "all") {
+		columns, err := client.AllColumns()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get fields: %w", err)
+		}
+		return columns, []string{"*all"}, nil
+	}
+
+	columns, err := client.ResolveColumns(names)
+	if err != nil {
+		return nil, nil, err
+	}
+	return columns, jira.ColumnFields(columns), nil
+}
+
+type exportWriter interface {
+	Write(row []string) error
+	Flush() error
+	Close() error
+}
+
+type csvExportWriter struct {
+	*csv.Writer
+}
+
+func (w csvExportWriter) Flush() error {
+	w.Writer.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
+
+func (w csvExportWriter) Close() error {
+	return w.Flush()
+}
+
+type xlsxExportWriter struct {
+	*output.XLSXWriter
+}
+
+func (w xlsxExportWriter) Flush() error {
+	return nil
+}
+
+func newExportWriter(format string, out io.Writer, header []string) (exportWriter, error) {
+	if format == "xlsx" {
+		x, err := output.NewXLSXWriter(out, "Issues")
+		if err != nil {
+			return nil, err
+		}
+		return xlsxExportWriter{x}, x.WriteHeader(header)
+	}
+
+	w := csvExportWriter{csv.NewWriter(out)}
+	if err := w.Write(header); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return w, nil
+}
+
+func exportIssues(client *jira.Client, jql string, columns []jira.Column, fields []string, format string, out io.Writer) (int, error) {
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = col.Name
+	}
+
+	w, err := newExportWriter(format, out, header)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	err = client.EachSearchPage(jql, fields, nil, func(page *jira.SearchResult) error {
+		for _, issue := range page.Issues {
+			row := make([]string, len(columns))
+			for i, col := range columns {
+				row[i] = col.Value(issue)
+			}
+			if err := w.Write(row); err != nil {
+				return err
+			}
+		}
+		count += len(page.Issues)
+		fmt.Fprintf(os.Stderr, "[%d/%d] issues exported\n", count, page.Total)
+		return w.Flush()
+	})
+	if err != nil {
+		return count, fmt.Errorf("export failed: %w", err)
+	}
+
+	return count, w.Close()
+}
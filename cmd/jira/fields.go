@@ -1,13 +1,12 @@
 package jira
 
 import (
-	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 var fieldsCmd = &cobra.Command{
@@ -17,7 +16,6 @@ var fieldsCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		name, _ := cmd.Flags().GetString("name")
 		customOnly, _ := cmd.Flags().GetBool("custom")
-		output := viper.GetString("output")
 
 		client := jira.NewClient()
 		fields, err := client.GetFields()
@@ -36,35 +34,16 @@ var fieldsCmd = &cobra.Command{
 			filtered = append(filtered, field)
 		}
 
-		if output == "json" {
-			jsonData, err := json.MarshalIndent(filtered, "", "  ")
-			if err != nil {
-				return fmt.Errorf("failed to marshal JSON: %w", err)
-			}
-			fmt.Println(string(jsonData))
-			return nil
-		}
-
-		if len(filtered) == 0 {
-			fmt.Println("No fields found matching criteria")
-			return nil
+		if output.Structured() {
+			return output.Print(filtered)
 		}
 
-		fmt.Println("| Field ID | Name | Custom | Type |")
-		fmt.Println("| -------- | ---- | ------ | ---- |")
+		table := output.NewTable("Field ID", "Name", "Custom", "Type")
+		table.Empty = "No fields found matching criteria"
 		for _, field := range filtered {
-			custom := "No"
-			if field.Custom {
-				custom = "Yes"
-			}
-			fieldType := field.Schema.Type
-			if fieldType == "" {
-				fieldType = "-"
-			}
-			fmt.Printf("| %s | %s | %s | %s |\n", field.ID, field.Name, custom, fieldType)
+			table.AddRow(field.ID, field.Name, yesNo(field.Custom), valueOrDash(field.Schema.Type))
 		}
-
-		return nil
+		return table.Print()
 	},
 }
 
@@ -0,0 +1,293 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+)
+
+var filtersCmd = &cobra.Command{
+	Use:   "filters",
+	Short: "List favourite and owned Jira filters",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		favourite, _ := cmd.Flags().GetBool("favourite")
+		owned, _ := cmd.Flags().GetBool("owned")
+		if !favourite && !owned {
+			favourite, owned = true, true
+		}
+
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+
+		var filters []jira.Filter
+		seen := make(map[string]bool)
+		if favourite {
+			favourites, err := client.GetFavouriteFilters()
+			if err != nil {
+				return fmt.Errorf("failed to get favourite filters: %w", err)
+			}
+			for _, f := range favourites {
+				f.Favourite = true
+				seen[f.ID] = true
+				filters = append(filters, f)
+			}
+		}
+		if owned {
+			if !client.IsCloud() {
+				fmt.Fprintln(os.Stderr, "Note: Jira Server only lists owned filters that are also favourites")
+			}
+			mine, err := client.GetOwnedFilters()
+			if err != nil {
+				return fmt.Errorf("failed to get owned filters: %w", err)
+			}
+			for _, f := range mine {
+				if !seen[f.ID] {
+					seen[f.ID] = true
+					filters = append(filters, f)
+				}
+			}
+		}
+
+		if output.Structured() {
+			return output.Print(filters)
+		}
+
+		table := output.NewTable("ID", "Name", "Owner", "Favourite", "JQL")
+		for _, f := range filters {
+			owner := "-"
+			if f.Owner != nil {
+				owner = f.Owner.DisplayName
+			}
+			table.AddRow(f.ID, f.Name, owner, yesNo(f.Favourite), f.JQL)
+		}
+		return table.Print()
+	},
+}
+
+var filterCmd = &cobra.Command{
+	Use:   "filter",
+	Short: "Manage Jira filters",
+	Long: `Show, create, update and delete Jira filters.
+
+A filter printed with 'filter get ID -o json' can be kept in version control and
+applied again with 'filter update ID --file filter.json' (JSON or YAML).`,
+}
+
+var filterGetCmd = &cobra.Command{
+	Use:   "get [id-or-name]",
+	Short: "Show a filter with its JQL and share permissions",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+
+		filter, err := client.FindFilter(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to get filter: %w", err)
+		}
+		if filter.SharePermissions == nil && filter.ID != "" {
+			if full, err := client.GetFilter(filter.ID); err == nil {
+				filter = full
+			}
+		}
+
+		if output.Structured() {
+			return output.Print(filter)
+		}
+
+		owner := "-"
+		if filter.Owner != nil {
+			owner = filter.Owner.DisplayName
+		}
+		shares := make([]string, len(filter.SharePermissions))
+		for i, p := range filter.SharePermissions {
+			shares[i] = p.String()
+		}
+
+		table := output.NewTable("Field", "Value")
+		table.AddRow("ID", filter.ID)
+		table.AddRow("Name", filter.Name)
+		table.AddRow("Owner", owner)
+		table.AddRow("Favourite", yesNo(filter.Favourite))
+		table.AddRow("Shared With", joinOrDash(shares))
+		table.AddRow("Description", valueOrDash(filter.Description))
+		table.AddRow("URL", valueOrDash(filter.ViewURL))
+		table.AddSection("JQL", filter.JQL)
+		return table.Print()
+	},
+}
+
+var filterCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a filter",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+
+		filter := jira.Filter{Favourite: true}
+		if err := applyFilterFlags(cmd, client, &filter); err != nil {
+			return err
+		}
+		if filter.Name == "" || filter.JQL == "" {
+			return fmt.Errorf("--name and --jql are required (or use --file)")
+		}
+
+		created, err := client.CreateFilter(filter)
+		if err != nil {
+			return fmt.Errorf("failed to create filter: %w", err)
+		}
+
+		fmt.Printf("Filter %s '%s' created\n", valueOrDash(created.ID), filter.Name)
+		if created.ViewURL != "" {
+			fmt.Printf("URL: %s\n", created.ViewURL)
+		}
+		return nil
+	},
+}
+
+var filterUpdateCmd = &cobra.Command{
+	Use:   "update [id-or-name]",
+	Short: "Update a filter",
+	Long: `Update the name, JQL, description or share permissions of a filter.
+--share replaces the existing share permissions.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+
+		existing, err := client.FindFilter(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to get filter: %w", err)
+		}
+		filter, err := client.GetFilter(existing.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get filter: %w", err)
+		}
+
+		if err := applyFilterFlags(cmd, client, filter); err != nil {
+			return err
+		}
+
+		if _, err := client.UpdateFilter(existing.ID, *filter); err != nil {
+			return fmt.Errorf("failed to update filter: %w", err)
+		}
+
+		fmt.Printf("Filter %s '%s' updated\n", existing.ID, filter.Name)
+		return nil
+	},
+}
+
+var filterDeleteCmd = &cobra.Command{
+	Use:   "delete [id-or-name]",
+	Short: "Delete a filter",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client := jira.NewClient()
+		filter, err := client.FindFilter(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to get filter: %w", err)
+		}
+
+		if err := client.DeleteFilter(filter.ID); err != nil {
+			return fmt.Errorf("failed to delete filter: %w", err)
+		}
+
+		fmt.Printf("Filter %s '%s' deleted\n", filter.ID, filter.Name)
+		return nil
+	},
+}
+
+func applyFilterFlags(cmd *cobra.Command, client *jira.Client, filter *jira.Filter) error {
+	if file, _ := cmd.Flags().GetString("file"); file != "" {
+		fromFile, err := readFilterFile(file)
+		if err != nil {
+			return err
+		}
+		filter.Name = fromFile.Name
+		filter.JQL = fromFile.JQL
+		filter.Description = fromFile.Description
+		filter.SharePermissions = fromFile.SharePermissions
+	}
+
+	if cmd.Flags().Changed("name") {
+		filter.Name, _ = cmd.Flags().GetString("name")
+	}
+	if cmd.Flags().Changed("jql") {
+		filter.JQL, _ = cmd.Flags().GetString("jql")
+	}
+	if cmd.Flags().Changed("description") {
+		filter.Description, _ = cmd.Flags().GetString("description")
+	}
+	if cmd.Flags().Changed("favourite") {
+		filter.Favourite, _ = cmd.Flags().GetBool("favourite")
+	}
+	if cmd.Flags().Changed("share") {
+		specs, _ := cmd.Flags().GetStringSlice("share")
+		filter.SharePermissions = nil
+		for _, spec := range specs {
+			permission, err := client.ParseSharePermission(spec)
+			if err != nil {
+				return err
+			}
+			filter.SharePermissions = append(filter.SharePermissions, *permission)
+		}
+	}
+
+	return nil
+}
+
+func readFilterFile(path string) (*jira.Filter, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var raw interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	normalized, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	var filter jira.Filter
+	if err := json.Unmarshal(normalized, &filter); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return &filter, nil
+}
+
+func init() {
+	Cmd.AddCommand(filtersCmd)
+	Cmd.AddCommand(filterCmd)
+	filterCmd.AddCommand(filterGetCmd)
+	filterCmd.AddCommand(filterCreateCmd)
+	filterCmd.AddCommand(filterUpdateCmd)
+	filterCmd.AddCommand(filterDeleteCmd)
+
+	filtersCmd.Flags().Bool("favourite", false, "Only favourite filters")
+	filtersCmd.Flags().Bool("owned", false, "Only filters you own")
+
+	for _, c := range []*cobra.Command{filterCreateCmd, filterUpdateCmd} {
+		c.Flags().String("name", "", "Filter name")
+		c.Flags().String("jql", "", "Filter JQL")
+		c.Flags().String("description", "", "Filter description")
+		c.Flags().Bool("favourite", true, "Mark the filter as a favourite")
+		c.Flags().StringSlice("share", nil, "Share with global, authenticated, group:NAME, project:KEY or user:USER (repeatable)")
+		c.Flags().String("file", "", "Read the filter from a JSON or YAML file (flags override it)")
+	}
+}
@@ -0,0 +1,111 @@
+package jira
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var reportFlowCmd = &cobra.Command{
+	Use:   "flow",
+	Short: "Cycle time, lead time and cumulative flow from changelogs",
+	Long: `Compute flow metrics for the issues matching a JQL query.
+
+For every issue the changelog is expanded to compute the time spent in each status
+until it reached Done, the cycle time (first move to an In Progress category status
+until Done) and the lead time (created until resolved), with percentiles for both.
+
+Use --cfd to output the cumulative flow diagram dataset (issues per status per day).
+
+Examples:
+  atlassian jira report flow --jql "project = PROJ AND resolved >= -30d"
+  atlassian jira report flow --jql "project = PROJ AND resolved >= -30d" -o csv > flow.csv
+  atlassian jira report flow --jql "project = PROJ" --cfd --cfd-days 60 -o csv`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		jql, _ := cmd.Flags().GetString("jql")
+		cfd, _ := cmd.Flags().GetBool("cfd")
+		cfdDays, _ := cmd.Flags().GetInt("cfd-days")
+
+		if jql == "" {
+			return fmt.Errorf("--jql is required")
+		}
+
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+		report, err := client.GetFlowReport(jql, cfdDays)
+		if err != nil {
+			return fmt.Errorf("failed to compute flow metrics: %w", err)
+		}
+
+		if output.Structured() {
+			if cfd {
+				return output.PrintList(report, report.CFD)
+			}
+			return output.PrintList(report, report.Issues)
+		}
+
+		if cfd {
+			table := output.NewTable(append([]string{"Date"}, report.Statuses...)...)
+			for _, day := range report.CFD {
+				row := []string{day.Date}
+				for _, status := range report.Statuses {
+					row = append(row, strconv.Itoa(day.Statuses[status]))
+				}
+				table.AddRow(row...)
+			}
+			return table.Print()
+		}
+
+		table := output.NewTable(append([]string{"Key", "Type", "Lead (d)", "Cycle (d)"}, report.Statuses...)...)
+		for _, issue := range report.Issues {
+			row := []string{issue.Key, issue.Type, optionalPoints(issue.LeadTimeDays), optionalPoints(issue.CycleTimeDays)}
+			for _, status := range report.Statuses {
+				row = append(row, formatPoints(issue.TimeInStatus[status]))
+			}
+			table.AddRow(row...)
+		}
+
+		if output.Human() {
+			if len(report.Issues) == 0 {
+				fmt.Println("No issues found")
+				return nil
+			}
+
+			metrics := output.NewTable("Metric", "Count", "Mean", "P50", "P75", "P85", "P95")
+			metrics.Title = fmt.Sprintf("Flow metrics for %d issues (days):", len(report.Issues))
+			metrics.AddRow(percentileRow("Cycle time", report.CycleTime)...)
+			metrics.AddRow(percentileRow("Lead time", report.LeadTime)...)
+			if err := metrics.Print(); err != nil {
+				return err
+			}
+			fmt.Println()
+		}
+		return table.Print()
+	},
+}
+
+func init() {
+	reportCmd.AddCommand(reportFlowCmd)
+
+	reportFlowCmd.Flags().String("jql", "", "JQL query selecting the issues (required)")
+	reportFlowCmd.Flags().Bool("cfd", false, "Output the cumulative flow diagram dataset")
+	reportFlowCmd.Flags().Int("cfd-days", 30, "Number of days covered by the cumulative flow dataset")
+}
+
+func percentileRow(name string, p jira.Percentiles) []string {
+	return []string{
+		name,
+		strconv.Itoa(p.Count),
+		strconv.FormatFloat(p.Mean, 'f', 1, 64),
+		formatPoints(p.P50),
+		formatPoints(p.P75),
+		formatPoints(p.P85),
+		formatPoints(p.P95),
+	}
+}
@@ -1,12 +1,11 @@
 package jira
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 var getCmd = &cobra.Command{
@@ -23,14 +22,11 @@ var getCmd = &cobra.Command{
 			return fmt.Errorf("failed to get issue: %w", err)
 		}
 
-		if viper.GetString("output") == "json" {
-			data, _ := json.MarshalIndent(issue, "", "  ")
-			fmt.Println(string(data))
-			return nil
+		if output.Structured() {
+			return output.Print(issue)
 		}
 
-		printIssue(issue)
-		return nil
+		return printIssue(issue)
 	},
 }
 
@@ -38,25 +34,25 @@ func init() {
 	Cmd.AddCommand(getCmd)
 }
 
-func printIssue(issue *jira.Issue) {
-	fmt.Printf("## %s\n\n", issue.Key)
-	fmt.Printf("| Campo | Valor |\n")
-	fmt.Printf("|-------|-------|\n")
-	fmt.Printf("| **Summary** | %s |\n", issue.Fields.Summary)
-	fmt.Printf("| **Status** | %s |\n", issue.Fields.Status.Name)
-	fmt.Printf("| **Priority** | %s |\n", issue.Fields.Priority.Name)
+func printIssue(issue *jira.Issue) error {
+	table := output.NewTable("Field", "Value")
+	table.Title = "## " + issue.Key
+	table.AddRow("Summary", issue.Fields.Summary)
+	table.AddRow("Status", issue.Fields.Status.Name)
+	table.AddRow("Priority", issue.Fields.Priority.Name)
 
 	if issue.Fields.Assignee != nil {
-		fmt.Printf("| **Assignee** | %s |\n", issue.Fields.Assignee.DisplayName)
+		table.AddRow("Assignee", issue.Fields.Assignee.DisplayName)
 	} else {
-		fmt.Printf("| **Assignee** | Unassigned |\n")
+		table.AddRow("Assignee", "Unassigned")
 	}
 
 	if issue.Fields.StoryPoints > 0 {
-		fmt.Printf("| **Story Points** | %.0f |\n", issue.Fields.StoryPoints)
+		table.AddRow("Story Points", fmt.Sprintf("%.0f", issue.Fields.StoryPoints))
 	}
 
 	if issue.Fields.Description != "" {
-		fmt.Printf("\n### Description\n\n%s\n", issue.Fields.Description)
+		table.AddSection("Description", issue.Fields.Description)
 	}
+	return table.Print()
 }
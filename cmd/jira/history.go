@@ -0,0 +1,93 @@
+package jira
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history [issue-key]",
+	Short: "Show the change history of an issue",
+	Long: `Show a chronological timeline of field changes, transitions, assignments and comments on an issue.
+
+Use --field to only show changes of specific fields (e.g. status, assignee, comment).
+
+Examples:
+  atlassian jira history PROJ-123
+  atlassian jira history PROJ-123 --field status
+  atlassian jira history PROJ-123 --field status,assignee -o json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		issueKey := args[0]
+		fieldFilter, _ := cmd.Flags().GetStringSlice("field")
+
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+
+		events, err := client.GetIssueHistory(issueKey)
+		if err != nil {
+			return fmt.Errorf("failed to get issue history: %w", err)
+		}
+
+		if len(fieldFilter) > 0 {
+			var filtered []jira.HistoryEvent
+			for _, event := range events {
+				for _, f := range fieldFilter {
+					if strings.EqualFold(event.Field, f) || event.FieldID == f {
+						filtered = append(filtered, event)
+						break
+					}
+				}
+			}
+			events = filtered
+		}
+
+		if output.Structured() {
+			return output.Print(events)
+		}
+
+		if len(events) == 0 && output.Human() {
+			fmt.Println("No history found")
+			return nil
+		}
+
+		table := output.NewTable("Date", "Author", "Type", "Field", "Change")
+		table.Title = fmt.Sprintf("History of %s:", issueKey)
+		for _, event := range events {
+			change := fmt.Sprintf("%s → %s", valueOrDash(event.From), valueOrDash(event.To))
+			if event.Type == "comment" {
+				change = event.To
+			}
+			table.AddRow(formatHistoryDate(event.Created), event.Author, event.Type, event.Field, change)
+		}
+		return table.Print()
+	},
+}
+
+func init() {
+	Cmd.AddCommand(historyCmd)
+
+	historyCmd.Flags().StringSlice("field", nil, "Only show changes to these fields (comma-separated)")
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return strings.ReplaceAll(value, "\n", " ")
+}
+
+func formatHistoryDate(created string) string {
+	t, err := jira.ParseTime(created)
+	if err != nil {
+		return created
+	}
+	return t.Local().Format("2006-01-02 15:04")
+}
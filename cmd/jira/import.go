@@ -0,0 +1,400 @@
+package jira
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/joselrodrigues/atlassian/internal/dryrun"
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Create issues from a CSV, JSON or YAML file",
+	Long: `Create one issue per row of a CSV file, or per object of a JSON or YAML list.
+
+Columns are matched to fields of the project's create screen by name or ID; --map renames
+columns that don't match (Column=field). Other columns are ignored. Users, sprints, epics and
+parents are given by name: an epic or parent can also be another row of the same file, which
+is then created first.
+
+Every row is validated against the create screen (required fields, allowed values) before
+anything is created; with --dry-run nothing else happens. Issues are created in batches with
+/issue/bulk, and the key created for each row is written to --keys-file as soon as its batch
+is done. Running the same import again skips the rows listed there, so a partial import can
+simply be resumed. Rows are numbered as in a spreadsheet for CSV (the header is row 1) and
+from 1 for JSON and YAML.
+
+Examples:
+  atlassian jira import issues.csv -p PROJ --map "Title=summary,Points=Story Points" --dry-run
+  atlassian jira import issues.csv -p PROJ --map "Title=summary,Points=Story Points" --yes
+  atlassian jira import backlog.yaml -p PROJ -t Task --keys-file backlog.keys.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := args[0]
+		issueType, _ := cmd.Flags().GetString("type")
+		maps, _ := cmd.Flags().GetStringSlice("map")
+		keysFile, _ := cmd.Flags().GetString("keys-file")
+		batch, _ := cmd.Flags().GetInt("batch")
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		if batch < 1 || batch > 50 {
+			return fmt.Errorf("--batch must be between 1 and 50")
+		}
+		if keysFile == "" {
+			keysFile = strings.TrimSuffix(path, filepath.Ext(path)) + ".keys.json"
+		}
+
+		mapping, err := jira.ParseImportMapping(maps)
+		if err != nil {
+			return err
+		}
+		rows, err := readImportFile(path)
+		if err != nil {
+			return err
+		}
+
+		project, err := projectFlag(cmd)
+		if err != nil {
+			return err
+		}
+		if !cmd.Flags().Changed("type") {
+			if defaultType := projectDefault(project, "issue_type"); defaultType != "" {
+				issueType = defaultType
+			}
+		}
+
+		keys, err := readImportKeys(keysFile)
+		if err != nil {
+			return err
+		}
+
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+
+		importer := jira.NewImporter(client, project, issueType, mapping)
+		var pending []jira.ImportRow
+		for _, row := range rows {
+			importer.AddLocal(row, keys[row.Number])
+			if keys[row.Number] == "" {
+				pending = append(pending, row)
+			}
+		}
+		if len(pending) == 0 {
+			fmt.Printf("All %d rows were already imported (see %s)\n", len(rows), keysFile)
+			return nil
+		}
+		if skipped := len(rows) - len(pending); skipped > 0 {
+			fmt.Fprintf(os.Stderr, "Skipping %d rows already imported (see %s)\n", skipped, keysFile)
+		}
+
+		var prepared []*jira.PreparedIssue
+		var problems []string
+		for _, row := range pending {
+			issue, err := importer.Prepare(row)
+			if err != nil {
+				problems = append(problems, err.Error())
+				continue
+			}
+			prepared = append(prepared, issue)
+		}
+		if ignored := importer.IgnoredColumns(); len(ignored) > 0 {
+			fmt.Fprintf(os.Stderr, "Warning: ignoring columns that are not on the create screen: %s\n", strings.Join(ignored, ", "))
+		}
+		if len(problems) > 0 {
+			return fmt.Errorf("%d of %d rows are invalid:\n  %s", len(problems), len(pending), strings.Join(problems, "\n  "))
+		}
+
+		if dryrun.Enabled() {
+			return printImportPlan(project, prepared)
+		}
+
+		if !yes {
+			if !isTerminal() {
+				return fmt.Errorf("refusing to create %d issues without confirmation (use --yes)", len(pending))
+			}
+			if !confirm(fmt.Sprintf("Create %d issues in %s?", len(pending), project)) {
+				return fmt.Errorf("aborted")
+			}
+		}
+
+		results := runImport(client, importer, pending, keys, keysFile, batch)
+		return printImportResults(results, keysFile)
+	},
+}
+
+func init() {
+	Cmd.AddCommand(importCmd)
+	importCmd.Flags().StringP("project", "p", "", "Project key (defaults to the configured project)")
+	importCmd.Flags().StringP("type", "t", "Story", "Issue type for rows without a type column (defaults to the project's default type)")
+	importCmd.Flags().StringSlice("map", nil, "Column to field mapping: Column=field (comma-separated or repeatable)")
+	importCmd.Flags().String("keys-file", "", "File recording the key created for each row (default <file>.keys.json)")
+	importCmd.Flags().Int("batch", 50, "Issues created per request (at most 50)")
+	importCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+}
+
+func runImport(client *jira.Client, importer *jira.Importer, pending []jira.ImportRow, keys map[int]string, keysFile string, batch int) []jira.ImportResult {
+	var results []jira.ImportResult
+	total := len(pending)
+
+	for len(pending) > 0 {
+		var ready []*jira.PreparedIssue
+		var waiting []jira.ImportRow
+		for _, row := range pending {
+			issue, err := importer.Prepare(row)
+			if err != nil {
+				results = append(results, jira.ImportResult{Row: row.Number, Error: err.Error()})
+				continue
+			}
+			if len(issue.Waiting) > 0 {
+				waiting = append(waiting, row)
+				continue
+			}
+			ready = append(ready, issue)
+		}
+
+		if len(ready) == 0 {
+			for _, row := range waiting {
+				results = append(results, jira.ImportResult{Row: row.Number, Error: "refers to rows that were not created"})
+			}
+			break
+		}
+
+		for start := 0; start < len(ready); start += batch {
+			end := start + batch
+			if end > len(ready) {
+				end = len(ready)
+			}
+			chunk := ready[start:end]
+
+			fields := make([]map[string]interface{}, len(chunk))
+			for i, issue := range chunk {
+				fields[i] = issue.Fields
+			}
+			created, err := client.CreateIssues(fields)
+
+			for i, issue := range chunk {
+				result := jira.ImportResult{Row: issue.Row}
+				switch {
+				case err != nil:
+					result.Error = err.Error()
+				case created[i].Error != "":
+					result.Error = created[i].Error
+				default:
+					result.Key = created[i].Key
+					keys[issue.Row] = result.Key
+					importer.SetKey(issue.Row, result.Key)
+				}
+				results = append(results, result)
+
+				status := result.Key
+				if result.Error != "" {
+					status = "FAILED: " + result.Error
+				}
+				fmt.Fprintf(os.Stderr, "[%d/%d] row %d %s\n", len(results), total, issue.Row, status)
+			}
+
+			if err := writeImportKeys(keysFile, keys); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			}
+		}
+
+		pending = waiting
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Row < results[j].Row })
+	return results
+}
+
+func printImportPlan(project string, issues []*jira.PreparedIssue) error {
+	if output.Structured() {
+		return output.Print(issues)
+	}
+
+	table := output.NewTable("Row", "Type", "Summary", "Fields", "Waiting For")
+	table.Title = fmt.Sprintf("%d issues would be created in %s:", len(issues), project)
+	for _, issue := range issues {
+		fields := make([]string, 0, len(issue.Fields))
+		for id := range issue.Fields {
+			if id != "project" && id != "issuetype" && id != "summary" {
+				fields = append(fields, id)
+			}
+		}
+		sort.Strings(fields)
+		table.AddRow(strconv.Itoa(issue.Row), issue.Type, issue.Summary, joinOrDash(fields), joinOrDash(issue.Waiting))
+	}
+	return table.Print()
+}
+
+func printImportResults(results []jira.ImportResult, keysFile string) error {
+	failed := 0
+	for _, r := range results {
+		if r.Error != "" {
+			failed++
+		}
+	}
+
+	if output.Structured() {
+		if err := output.PrintList(results, results); err != nil {
+			return err
+		}
+	} else {
+		table := output.NewTable("Row", "Key", "Error")
+		for _, r := range results {
+			table.AddRow(strconv.Itoa(r.Row), valueOrDash(r.Key), valueOrDash(r.Error))
+		}
+		if output.Human() {
+			fmt.Println()
+			table.Footer = fmt.Sprintf("%d created, %d failed", len(results)-failed, failed)
+			if failed > 0 {
+				table.Footer += fmt.Sprintf("\nCreated keys are in %s; run the same command again to retry the failed rows", keysFile)
+			}
+		}
+		if err := table.Print(); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d rows failed", failed, len(results))
+	}
+	return nil
+}
+
+func readImportFile(path string) ([]jira.ImportRow, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read import file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return parseImportCSV(data)
+	case ".json":
+		var records []map[string]interface{}
+		if err := json.Unmarshal(data, &records); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: expected a list of objects: %w", path, err)
+		}
+		return importRecords(records), nil
+	case ".yaml", ".yml":
+		var records []map[string]interface{}
+		if err := yaml.Unmarshal(data, &records); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: expected a list of objects: %w", path, err)
+		}
+		return importRecords(records), nil
+	}
+	return nil, fmt.Errorf("unsupported import file '%s' (use .csv, .json, .yaml or .yml)", path)
+}
+
+func parseImportCSV(data []byte) ([]jira.ImportRow, error) {
+	reader := csv.NewReader(strings.NewReader(strings.TrimPrefix(string(data), "\ufeff")))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("CSV file is empty")
+	}
+
+	header := records[0]
+	seen := make(map[string]bool)
+	for i, name := range header {
+		header[i] = strings.TrimSpace(name)
+		if seen[strings.ToLower(header[i])] {
+			return nil, fmt.Errorf("duplicate CSV column '%s'", header[i])
+		}
+		seen[strings.ToLower(header[i])] = true
+	}
+
+	rows := make([]jira.ImportRow, 0, len(records)-1)
+	for i, record := range records[1:] {
+		row := jira.ImportRow{Number: i + 2, Values: make(map[string]string)}
+		for j, value := range record {
+			if j < len(header) && header[j] != "" {
+				row.Values[header[j]] = value
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func importRecords(records []map[string]interface{}) []jira.ImportRow {
+	rows := make([]jira.ImportRow, len(records))
+	for i, record := range records {
+		rows[i] = jira.ImportRow{Number: i + 1, Values: make(map[string]string)}
+		for column, value := range record {
+			rows[i].Values[column] = importValue(value)
+		}
+	}
+	return rows
+}
+
+func importValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = importValue(item)
+		}
+		return strings.Join(parts, ",")
+	}
+	return fmt.Sprint(value)
+}
+
+func readImportKeys(path string) (map[int]string, error) {
+	keys := make(map[int]string)
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return keys, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keys file: %w", err)
+	}
+
+	var stored map[string]string
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("failed to parse keys file %s: %w", path, err)
+	}
+	for row, key := range stored {
+		n, err := strconv.Atoi(row)
+		if err != nil {
+			return nil, fmt.Errorf("invalid row '%s' in keys file %s", row, path)
+		}
+		keys[n] = key
+	}
+	return keys, nil
+}
+
+func writeImportKeys(path string, keys map[int]string) error {
+	stored := make(map[string]string, len(keys))
+	for row, key := range keys {
+		stored[strconv.Itoa(row)] = key
+	}
+	data, err := json.MarshalIndent(stored, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal keys file: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write keys file: %w", err)
+	}
+	return nil
+}
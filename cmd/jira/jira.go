@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -12,8 +13,9 @@ var Cmd = &cobra.Command{
 	Use:   "jira",
 	Short: "Jira operations",
 	Long:  `Commands for interacting with Jira: issues, comments, transitions, sprints, and more.`,
-	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		validateConfig()
+		return output.Validate()
 	},
 }
 
@@ -0,0 +1,83 @@
+package jira
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var jqlCmd = &cobra.Command{
+	Use:   "jql",
+	Short: "JQL utilities",
+}
+
+var jqlValidateCmd = &cobra.Command{
+	Use:   "validate [jql | @query [name=value...]]",
+	Short: "Check a JQL query for errors",
+	Long: `Check a JQL query for errors. On Jira Cloud the query is validated by Jira
+(including unknown fields and values); otherwise, or with --local, only the syntax
+is checked and errors are reported with their position.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		local, _ := cmd.Flags().GetBool("local")
+
+		jql, err := resolveJQL(args)
+		if err != nil {
+			return err
+		}
+
+		var errs []string
+		if local {
+			if err := jira.ParseJQL(jql); err != nil {
+				errs = append(errs, err.Error())
+			}
+		} else {
+			client := jira.NewClient()
+			if err := client.DetectInstanceType(); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+			}
+			if errs, err = client.ValidateJQL(jql); err != nil {
+				return fmt.Errorf("failed to validate JQL: %w", err)
+			}
+		}
+
+		if output.Structured() {
+			if errs == nil {
+				errs = []string{}
+			}
+			if err := output.Print(map[string]interface{}{"jql": jql, "valid": len(errs) == 0, "errors": errs}); err != nil {
+				return err
+			}
+		} else if len(errs) == 0 {
+			fmt.Println("JQL is valid")
+		} else {
+			var syntaxErr *jira.JQLSyntaxError
+			if errors.As(jira.ParseJQL(jql), &syntaxErr) && errs[0] == syntaxErr.Error() {
+				fmt.Println(caretLine(jql, syntaxErr))
+			}
+		}
+
+		if len(errs) > 0 {
+			cmd.SilenceUsage = true
+			return fmt.Errorf("invalid JQL: %s", strings.Join(errs, "; "))
+		}
+		return nil
+	},
+}
+
+func caretLine(jql string, err *jira.JQLSyntaxError) string {
+	line := strings.Split(jql, "\n")[err.Line-1]
+	prefix := jql[strings.LastIndex(jql[:err.Offset], "\n")+1 : err.Offset]
+	return line + "\n" + strings.Repeat(" ", output.DisplayWidth(prefix)) + "^"
+}
+
+func init() {
+	Cmd.AddCommand(jqlCmd)
+	jqlCmd.AddCommand(jqlValidateCmd)
+	jqlValidateCmd.Flags().Bool("local", false, "Only check the syntax locally, without calling Jira")
+}
@@ -0,0 +1,129 @@
+package jira
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var labelsCmd = &cobra.Command{
+	Use:   "labels",
+	Short: "List and manage labels",
+	Long: `List label usage across the issues of a JQL query, rename labels and bulk add or remove labels.
+
+Examples:
+  atlassian jira labels --jql "project = PROJ"
+  atlassian jira labels rename backend back-end --jql "project = PROJ"
+  atlassian jira labels add triaged --jql "project = PROJ AND status = Open"
+  atlassian jira labels remove wip stale --jql "project = PROJ AND resolution is not EMPTY"`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		jql, _ := cmd.Flags().GetString("jql")
+
+		if jql == "" {
+			return fmt.Errorf("--jql is required")
+		}
+
+		client := jira.NewClient()
+		usage, err := client.GetLabelUsage(jql)
+		if err != nil {
+			return fmt.Errorf("failed to get labels: %w", err)
+		}
+
+		if output.Structured() {
+			return output.Print(usage)
+		}
+
+		table := output.NewTable("Label", "Issues")
+		table.Empty = "No labels found"
+		for _, u := range usage {
+			table.AddRow(u.Label, strconv.Itoa(u.Issues))
+		}
+		return table.Print()
+	},
+}
+
+var labelsRenameCmd = &cobra.Command{
+	Use:   "rename [old-label] [new-label]",
+	Short: "Rename a label on all matching issues",
+	Long:  `Replace a label with another on every issue that has it, optionally restricted by --jql.`,
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		oldLabel, newLabel := args[0], args[1]
+		jql, _ := cmd.Flags().GetString("jql")
+
+		query := "labels = " + jira.QuoteJQL(oldLabel)
+		if jql != "" {
+			query = fmt.Sprintf("(%s) AND %s", jql, query)
+		}
+
+		return modifyLabels(query, []string{newLabel}, []string{oldLabel})
+	},
+}
+
+var labelsAddCmd = &cobra.Command{
+	Use:   "add [label...]",
+	Short: "Add labels to all issues matching --jql",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		jql, _ := cmd.Flags().GetString("jql")
+		if jql == "" {
+			return fmt.Errorf("--jql is required")
+		}
+		return modifyLabels(jql, args, nil)
+	},
+}
+
+var labelsRemoveCmd = &cobra.Command{
+	Use:   "remove [label...]",
+	Short: "Remove labels from all issues matching --jql",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		jql, _ := cmd.Flags().GetString("jql")
+		if jql == "" {
+			return fmt.Errorf("--jql is required")
+		}
+		return modifyLabels(jql, nil, args)
+	},
+}
+
+func init() {
+	Cmd.AddCommand(labelsCmd)
+	labelsCmd.AddCommand(labelsRenameCmd)
+	labelsCmd.AddCommand(labelsAddCmd)
+	labelsCmd.AddCommand(labelsRemoveCmd)
+
+	labelsCmd.PersistentFlags().String("jql", "", "JQL query selecting the issues")
+}
+
+func modifyLabels(jql string, add, remove []string) error {
+	client := jira.NewClient()
+	issues, err := client.SearchAllIssues(jql, []string{"key"}, nil)
+	if err != nil {
+		return fmt.Errorf("search failed: %w", err)
+	}
+
+	if len(issues) == 0 {
+		fmt.Println("No issues found")
+		return nil
+	}
+
+	var failed int
+	for _, issue := range issues {
+		if err := client.ModifyLabels(issue.Key, add, remove); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", issue.Key, err)
+			failed++
+			continue
+		}
+		fmt.Printf("%s updated\n", issue.Key)
+	}
+
+	fmt.Printf("\n%d of %d issues updated\n", len(issues)-failed, len(issues))
+	if failed > 0 {
+		return fmt.Errorf("%d issues failed", failed)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package jira
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/joselrodrigues/atlassian/internal/dryrun"
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var moveCmd = &cobra.Command{
+	Use:   "move [issue-key]",
+	Short: "Move an issue to a status through the workflow",
+	Long: `Move an issue to a target status, chaining as many transitions as needed.
+The shortest path is found over the workflow graph learned from previous moves
+(cached per project and issue type). Statuses not seen yet are explored on the way.
+With --dry-run the planned path is printed and nothing is changed.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		issueKey := args[0]
+		target, _ := cmd.Flags().GetString("to")
+		maxSteps, _ := cmd.Flags().GetInt("max-steps")
+		resolution, _ := cmd.Flags().GetString("resolution")
+		comment, _ := cmd.Flags().GetString("comment")
+
+		client := jira.NewClient()
+
+		if dryrun.Enabled() {
+			plan, err := client.PlanMove(issueKey, target)
+			if err != nil {
+				return fmt.Errorf("failed to plan move: %w", err)
+			}
+			return printMovePlan(plan)
+		}
+
+		steps, err := client.MoveIssue(issueKey, target, jira.MoveOptions{
+			MaxSteps:   maxSteps,
+			Resolution: resolution,
+			Comment:    comment,
+			OnStep: func(step jira.WorkflowStep) {
+				fmt.Printf("%s: %s -> %s (%s)\n", issueKey, step.From, step.Edge.To, step.Edge.Name)
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to move issue: %w", err)
+		}
+
+		if len(steps) == 0 {
+			fmt.Printf("Issue %s is already in '%s'\n", issueKey, target)
+			return nil
+		}
+		fmt.Printf("Issue %s moved to '%s' in %d transition(s)\n", issueKey, steps[len(steps)-1].Edge.To, len(steps))
+		return nil
+	},
+}
+
+func printMovePlan(plan *jira.MovePlan) error {
+	if output.Structured() {
+		return output.Print(plan)
+	}
+
+	if !plan.Reachable {
+		msg := fmt.Sprintf("no known path from '%s' to '%s'", plan.From, plan.To)
+		if len(plan.Unknown) > 0 {
+			msg += fmt.Sprintf("; statuses not explored yet: %s", strings.Join(plan.Unknown, ", "))
+		}
+		return fmt.Errorf("%s", msg)
+	}
+
+	if len(plan.Steps) == 0 {
+		fmt.Printf("Issue %s is already in '%s'\n", plan.Issue, plan.From)
+		return nil
+	}
+
+	table := output.NewTable("Step", "From", "Transition", "ID", "To")
+	table.Title = fmt.Sprintf("Planned path for %s (%s -> %s):", plan.Issue, plan.From, plan.Steps[len(plan.Steps)-1].Edge.To)
+	for i, step := range plan.Steps {
+		table.AddRow(strconv.Itoa(i+1), step.From, step.Edge.Name, step.Edge.TransitionID, step.Edge.To)
+	}
+	return table.Print()
+}
+
+func init() {
+	Cmd.AddCommand(moveCmd)
+	moveCmd.Flags().String("to", "", "Target status")
+	moveCmd.Flags().Int("max-steps", 10, "Maximum number of transitions to perform")
+	moveCmd.Flags().String("resolution", "", "Resolution to set on the final transition")
+	moveCmd.Flags().String("comment", "", "Comment to add on the final transition")
+	moveCmd.MarkFlagRequired("to")
+}
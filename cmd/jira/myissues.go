@@ -1,36 +1,153 @@
 package jira
 
 import (
-	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
+var myIssuesSortFields = map[string]bool{
+	"updated": true, "created": true, "priority": true, "status": true,
+	"key": true, "project": true, "duedate": true, "rank": true,
+}
+
+type myIssuesGroup struct {
+	Group  string       `json:"group"`
+	Total  int          `json:"total"`
+	Issues []jira.Issue `json:"issues"`
+}
+
 var myIssuesCmd = &cobra.Command{
 	Use:   "my-issues",
 	Short: "List my assigned open issues",
-	Long:  `List all open issues assigned to the current user.`,
+	Long: `List issues assigned to the current user that are not in a Done status category.
+Use --status to list specific statuses instead, and --group-by to split the output
+by project or status.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		statuses, _ := cmd.Flags().GetStringSlice("status")
+		projects, _ := cmd.Flags().GetStringSlice("project")
+		types, _ := cmd.Flags().GetStringSlice("type")
+		updatedSince, _ := cmd.Flags().GetString("updated-since")
+		sortBy, _ := cmd.Flags().GetString("sort")
+		ascending, _ := cmd.Flags().GetBool("asc")
+		groupBy, _ := cmd.Flags().GetString("group-by")
+		maxResults, _ := cmd.Flags().GetInt("max")
+
+		if !myIssuesSortFields[sortBy] {
+			return fmt.Errorf("unsupported sort field '%s' (use updated, created, priority, status, key, project, duedate or rank)", sortBy)
+		}
+		if groupBy != "" && groupBy != "project" && groupBy != "status" {
+			return fmt.Errorf("unsupported grouping '%s' (use project or status)", groupBy)
+		}
+
 		client := jira.NewClient()
-		result, err := client.GetMyIssues()
+		columns, err := issueColumns(cmd, client)
+		if err != nil {
+			return err
+		}
+		fields := issueFields(columns)
+		if len(fields) > 0 && groupBy != "" {
+			fields = append(fields, groupBy)
+		}
+
+		result, err := client.GetMyIssues(jira.MyIssuesOptions{
+			Statuses:     statuses,
+			Projects:     projects,
+			Types:        types,
+			UpdatedSince: updatedSince,
+			OrderBy:      sortBy,
+			Ascending:    ascending,
+			MaxResults:   maxResults,
+			Fields:       fields,
+		})
 		if err != nil {
 			return fmt.Errorf("failed to get issues: %w", err)
 		}
 
-		if viper.GetString("output") == "json" {
-			data, _ := json.MarshalIndent(result, "", "  ")
-			fmt.Println(string(data))
-			return nil
+		if groupBy == "" {
+			if output.Structured() {
+				return output.PrintList(result, result.Issues)
+			}
+
+			return printSearchResults(result, columns)
+		}
+
+		groups := groupIssues(result.Issues, groupBy)
+		if output.Structured() {
+			return output.Print(groups)
+		}
+
+		if !output.Human() {
+			table := output.NewTable(append([]string{"Group"}, issueTable(columns, nil).Header...)...)
+			for _, g := range groups {
+				for _, row := range issueTable(columns, g.Issues).Rows {
+					table.AddRow(append([]string{g.Group}, row...)...)
+				}
+			}
+			return table.Print()
 		}
 
-		printSearchResults(result)
+		fmt.Printf("Found %d issues:\n", result.Total)
+		for _, g := range groups {
+			fmt.Println()
+			table := issueTable(columns, g.Issues)
+			table.Title = fmt.Sprintf("## %s (%d)", g.Group, g.Total)
+			if err := table.Print(); err != nil {
+				return err
+			}
+		}
 		return nil
 	},
 }
 
+func groupIssues(issues []jira.Issue, groupBy string) []myIssuesGroup {
+	index := make(map[string]int)
+	rank := make(map[string]int)
+	var groups []myIssuesGroup
+
+	for _, issue := range issues {
+		name := issue.Fields.Project.Key
+		if groupBy == "status" {
+			name = issue.Fields.Status.Name
+			rank[name] = len(categoryOrder)
+			if issue.Fields.Status.StatusCategory != nil {
+				if r, ok := categoryOrder[issue.Fields.Status.StatusCategory.Key]; ok {
+					rank[name] = r
+				}
+			}
+		}
+
+		i, ok := index[name]
+		if !ok {
+			i = len(groups)
+			index[name] = i
+			groups = append(groups, myIssuesGroup{Group: name})
+		}
+		groups[i].Issues = append(groups[i].Issues, issue)
+		groups[i].Total++
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		if rank[groups[i].Group] != rank[groups[j].Group] {
+			return rank[groups[i].Group] < rank[groups[j].Group]
+		}
+		return groups[i].Group < groups[j].Group
+	})
+	return groups
+}
+
 func init() {
 	Cmd.AddCommand(myIssuesCmd)
+	myIssuesCmd.Flags().StringSlice("status", nil, "Only these statuses (default: every status not in the Done category)")
+	myIssuesCmd.Flags().StringSliceP("project", "p", nil, "Only these projects")
+	myIssuesCmd.Flags().StringSliceP("type", "t", nil, "Only these issue types")
+	myIssuesCmd.Flags().String("updated-since", "", "Only issues updated since a date (YYYY-MM-DD) or period (7d, 2w)")
+	myIssuesCmd.Flags().String("sort", "updated", "Sort by updated, created, priority, status, key, project, duedate or rank")
+	myIssuesCmd.Flags().Bool("asc", false, "Sort in ascending order")
+	myIssuesCmd.Flags().String("group-by", "", "Group output by project or status")
+	myIssuesCmd.Flags().IntP("max", "m", 50, "Maximum results to return")
+	myIssuesCmd.Flags().StringSlice("columns", nil, "Columns to show, by field name or ID (e.g. key,status,priority,updated)")
 }
@@ -0,0 +1,214 @@
+package jira
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/joselrodrigues/atlassian/internal/config"
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var projectsCmd = &cobra.Command{
+	Use:   "projects",
+	Short: "List projects",
+	Long:  `List all Jira projects visible to the current user.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+
+		projects, err := client.GetProjects()
+		if err != nil {
+			return fmt.Errorf("failed to get projects: %w", err)
+		}
+
+		if output.Structured() {
+			return output.Print(projects)
+		}
+
+		defaultProject := viper.GetString("jira_project")
+		table := output.NewTable("Key", "Name", "Type", "Lead")
+		table.Empty = "No projects found"
+		for _, p := range projects {
+			key := p.Key
+			if strings.EqualFold(key, defaultProject) {
+				key += " (default)"
+			}
+			lead := "-"
+			if p.Lead != nil {
+				lead = p.Lead.DisplayName
+			}
+			table.AddRow(key, p.Name, valueOrDash(p.ProjectTypeKey), lead)
+		}
+		return table.Print()
+	},
+}
+
+var projectCmd = &cobra.Command{
+	Use:   "project [project-key]",
+	Short: "Show project details and manage project defaults",
+	Long: `Show a project's lead, issue types, components, versions and roles.
+
+Project defaults are stored in the config file and are picked up by other commands
+when --project or --board is omitted:
+  --set-default       use this project when -p is omitted
+  --default-board     board used by sprints and reports for this project
+  --default-type      issue type used by create for this project
+
+Examples:
+  atlassian jira project PROJ
+  atlassian jira project PROJ --set-default --default-board 12 --default-type Bug`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		setDefault, _ := cmd.Flags().GetBool("set-default")
+		defaultBoard, _ := cmd.Flags().GetInt("default-board")
+		defaultType, _ := cmd.Flags().GetString("default-type")
+
+		projectKey := viper.GetString("jira_project")
+		if len(args) == 1 {
+			projectKey = args[0]
+		}
+		if projectKey == "" {
+			return fmt.Errorf("project key is required")
+		}
+		projectKey = strings.ToUpper(projectKey)
+
+		if setDefault || cmd.Flags().Changed("default-board") || cmd.Flags().Changed("default-type") {
+			if setDefault {
+				if err := config.Set([]string{"jira_project"}, projectKey); err != nil {
+					return err
+				}
+			}
+			if cmd.Flags().Changed("default-board") {
+				if err := config.Set([]string{"projects", projectKey, "board"}, defaultBoard); err != nil {
+					return err
+				}
+			}
+			if cmd.Flags().Changed("default-type") {
+				if err := config.Set([]string{"projects", projectKey, "issue_type"}, defaultType); err != nil {
+					return err
+				}
+			}
+			fmt.Printf("Defaults for %s saved to %s\n", projectKey, config.Path())
+			return nil
+		}
+
+		client := jira.NewClient()
+		project, err := client.GetProject(projectKey)
+		if err != nil {
+			return fmt.Errorf("failed to get project: %w", err)
+		}
+
+		if output.Structured() {
+			return output.Print(project)
+		}
+
+		return printProject(project)
+	},
+}
+
+func init() {
+	Cmd.AddCommand(projectsCmd)
+	Cmd.AddCommand(projectCmd)
+
+	projectCmd.Flags().Bool("set-default", false, "Use this project when --project is omitted")
+	projectCmd.Flags().Int("default-board", 0, "Default board ID for this project")
+	projectCmd.Flags().String("default-type", "", "Default issue type for this project")
+}
+
+func printProject(project *jira.ProjectDetails) error {
+	table := output.NewTable("Field", "Value")
+	table.Title = fmt.Sprintf("## %s - %s", project.Key, project.Name)
+	if project.Lead != nil {
+		table.AddRow("Lead", project.Lead.DisplayName)
+	}
+	if project.ProjectTypeKey != "" {
+		table.AddRow("Type", project.ProjectTypeKey)
+	}
+
+	var issueTypes []string
+	for _, t := range project.IssueTypes {
+		issueTypes = append(issueTypes, t.Name)
+	}
+	table.AddRow("Issue Types", joinOrDash(issueTypes))
+
+	var components []string
+	for _, c := range project.Components {
+		components = append(components, c.Name)
+	}
+	table.AddRow("Components", joinOrDash(components))
+
+	var versions []string
+	for _, v := range project.Versions {
+		if !v.Archived {
+			versions = append(versions, v.Name)
+		}
+	}
+	table.AddRow("Versions", joinOrDash(versions))
+
+	var roles []string
+	for name := range project.Roles {
+		roles = append(roles, name)
+	}
+	sort.Strings(roles)
+	table.AddRow("Roles", joinOrDash(roles))
+
+	if board := projectDefault(project.Key, "board"); board != "" {
+		table.AddRow("Default Board", board)
+	}
+	if issueType := projectDefault(project.Key, "issue_type"); issueType != "" {
+		table.AddRow("Default Issue Type", issueType)
+	}
+
+	if project.Description != "" {
+		table.AddSection("Description", project.Description)
+	}
+	return table.Print()
+}
+
+func joinOrDash(values []string) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	return strings.Join(values, ", ")
+}
+
+func projectDefault(projectKey, key string) string {
+	return viper.GetString("projects." + strings.ToLower(projectKey) + "." + key)
+}
+
+func projectFlag(cmd *cobra.Command) (string, error) {
+	project, _ := cmd.Flags().GetString("project")
+	if project == "" {
+		project = viper.GetString("jira_project")
+	}
+	if project == "" {
+		return "", fmt.Errorf("--project is required (or set a default with 'jira project KEY --set-default')")
+	}
+	return project, nil
+}
+
+func boardFlag(cmd *cobra.Command) (int, error) {
+	boardID, _ := cmd.Flags().GetInt("board")
+	if boardID != 0 {
+		return boardID, nil
+	}
+
+	project, _ := cmd.Flags().GetString("project")
+	if project == "" {
+		project = viper.GetString("jira_project")
+	}
+	if project != "" {
+		if boardID = viper.GetInt("projects." + strings.ToLower(project) + ".board"); boardID != 0 {
+			return boardID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("--board is required (or set a default with 'jira project KEY --default-board ID')")
+}
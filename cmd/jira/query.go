@@ -0,0 +1,211 @@
+package jira
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/joselrodrigues/atlassian/internal/config"
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var querySlugPattern = regexp.MustCompile(`[^a-z0-9_]+`)
+
+var queryCmd = &cobra.Command{
+	Use:   "query",
+	Short: "Manage saved JQL queries",
+	Long: `Save JQL queries in the config file and run them with 'jira search @name'.
+
+Queries may contain {placeholders} that are filled from name=value arguments:
+
+  atlassian jira query save team-sprint "project = {project} AND sprint in openSprints()"
+  atlassian jira search @team-sprint project=PROJ`,
+}
+
+var querySaveCmd = &cobra.Command{
+	Use:   "save [name] [jql]",
+	Short: "Save a JQL query",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		description, _ := cmd.Flags().GetString("description")
+
+		q := config.Query{Name: args[0], JQL: args[1], Description: description}
+		if existing, err := config.GetQuery(args[0]); err == nil {
+			q.FilterID = existing.FilterID
+			if !cmd.Flags().Changed("description") {
+				q.Description = existing.Description
+			}
+		}
+
+		if err := config.SaveQuery(q); err != nil {
+			return fmt.Errorf("failed to save query: %w", err)
+		}
+
+		name, _ := config.QueryName(args[0])
+		fmt.Printf("Query '%s' saved. Run it with: atlassian jira search @%s\n", name, name)
+		return nil
+	},
+}
+
+var queryListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List saved queries",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		queries := config.Queries()
+
+		if output.Structured() {
+			return output.Print(queries)
+		}
+
+		table := output.NewTable("Name", "JQL", "Parameters", "Filter", "Description")
+		table.Empty = "No saved queries. Add one with 'atlassian jira query save NAME JQL'."
+		for _, q := range queries {
+			table.AddRow("@"+q.Name, q.JQL, joinOrDash(q.Placeholders()), valueOrDash(q.FilterID), valueOrDash(q.Description))
+		}
+		return table.Print()
+	},
+}
+
+var queryDeleteCmd = &cobra.Command{
+	Use:   "delete [name]",
+	Short: "Delete a saved query",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := config.DeleteQuery(args[0]); err != nil {
+			return fmt.Errorf("failed to delete query: %w", err)
+		}
+
+		fmt.Printf("Query '%s' deleted\n", args[0])
+		return nil
+	},
+}
+
+var queryImportCmd = &cobra.Command{
+	Use:   "import [filter-name-or-id...]",
+	Short: "Import favourite Jira filters as saved queries",
+	Long: `Import your favourite Jira filters as saved queries. Without arguments every
+favourite filter is imported. Existing queries are kept unless --overwrite is set.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		overwrite, _ := cmd.Flags().GetBool("overwrite")
+
+		client := jira.NewClient()
+		filters, err := client.GetFavouriteFilters()
+		if err != nil {
+			return fmt.Errorf("failed to get favourite filters: %w", err)
+		}
+
+		imported := 0
+		for _, f := range filters {
+			if len(args) > 0 && !matchesFilter(f, args) {
+				continue
+			}
+
+			name := strings.Trim(querySlugPattern.ReplaceAllString(strings.ToLower(f.Name), "-"), "-_")
+			if name == "" {
+				name = "filter-" + f.ID
+			}
+			if _, err := config.GetQuery(name); err == nil && !overwrite {
+				fmt.Fprintf(os.Stderr, "Skipping '%s': query @%s already exists (use --overwrite)\n", f.Name, name)
+				continue
+			}
+
+			q := config.Query{Name: name, JQL: f.JQL, Description: f.Description, FilterID: f.ID}
+			if err := config.SaveQuery(q); err != nil {
+				return fmt.Errorf("failed to save query '%s': %w", name, err)
+			}
+			fmt.Printf("Imported filter %s '%s' as @%s\n", f.ID, f.Name, name)
+			imported++
+		}
+
+		fmt.Printf("%d filter(s) imported\n", imported)
+		return nil
+	},
+}
+
+var queryExportCmd = &cobra.Command{
+	Use:   "export [name...]",
+	Short: "Create or update Jira filters from saved queries",
+	Long: `Create a Jira filter for each saved query, or update the filter it was imported
+from or previously exported to. Queries with placeholders cannot be exported.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		all, _ := cmd.Flags().GetBool("all")
+
+		var queries []config.Query
+		if all {
+			queries = config.Queries()
+		} else {
+			if len(args) == 0 {
+				return fmt.Errorf("query name is required (or use --all)")
+			}
+			for _, name := range args {
+				q, err := config.GetQuery(name)
+				if err != nil {
+					return err
+				}
+				queries = append(queries, *q)
+			}
+		}
+
+		client := jira.NewClient()
+		for _, q := range queries {
+			if placeholders := q.Placeholders(); len(placeholders) > 0 {
+				fmt.Fprintf(os.Stderr, "Skipping @%s: it has placeholders (%s)\n", q.Name, strings.Join(placeholders, ", "))
+				continue
+			}
+
+			filter := jira.Filter{Name: q.Name, JQL: q.JQL, Description: q.Description}
+			if q.FilterID != "" {
+				existing, err := client.GetFilter(q.FilterID)
+				if err != nil {
+					return fmt.Errorf("failed to get filter %s: %w", q.FilterID, err)
+				}
+				filter.Name = existing.Name
+				if _, err := client.UpdateFilter(q.FilterID, filter); err != nil {
+					return fmt.Errorf("failed to update filter %s: %w", q.FilterID, err)
+				}
+				fmt.Printf("Updated filter %s from @%s\n", q.FilterID, q.Name)
+				continue
+			}
+
+			filter.Favourite = true
+			created, err := client.CreateFilter(filter)
+			if err != nil {
+				return fmt.Errorf("failed to create filter for @%s: %w", q.Name, err)
+			}
+			if created.ID != "" {
+				q.FilterID = created.ID
+				if err := config.SaveQuery(q); err != nil {
+					return fmt.Errorf("failed to save query: %w", err)
+				}
+			}
+			fmt.Printf("Created filter %s from @%s\n", valueOrDash(created.ID), q.Name)
+		}
+
+		return nil
+	},
+}
+
+func matchesFilter(f jira.Filter, refs []string) bool {
+	for _, ref := range refs {
+		if f.ID == ref || strings.EqualFold(f.Name, ref) {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	Cmd.AddCommand(queryCmd)
+	queryCmd.AddCommand(querySaveCmd)
+	queryCmd.AddCommand(queryListCmd)
+	queryCmd.AddCommand(queryDeleteCmd)
+	queryCmd.AddCommand(queryImportCmd)
+	queryCmd.AddCommand(queryExportCmd)
+
+	querySaveCmd.Flags().String("description", "", "Description of the query")
+	queryImportCmd.Flags().Bool("overwrite", false, "Replace existing queries with the same name")
+	queryExportCmd.Flags().Bool("all", false, "Export every saved query")
+}
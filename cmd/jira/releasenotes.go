@@ -0,0 +1,157 @@
+package jira
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+
+	"github.com/joselrodrigues/atlassian/internal/confluence"
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var releaseNotesCmd = &cobra.Command{
+	Use:   "release-notes [project] [version]",
+	Short: "Generate release notes for a version",
+	Long: `Generate release notes from the issues of a fixVersion, grouped by issue type.
+
+Formats: md (default), html, confluence (storage format with Jira issue macros).
+Use --publish-space to publish the notes as a Confluence page (requires CONFLUENCE_TOKEN
+and CONFLUENCE_BASE_URL).
+
+Examples:
+  atlassian jira release-notes PROJ 2.4.0
+  atlassian jira release-notes PROJ 2.4.0 --format html > notes.html
+  atlassian jira release-notes PROJ 2.4.0 --publish-space DOCS --parent 123456`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		project, versionName := args[0], args[1]
+		format, _ := cmd.Flags().GetString("format")
+		space, _ := cmd.Flags().GetString("publish-space")
+		parentID, _ := cmd.Flags().GetString("parent")
+		title, _ := cmd.Flags().GetString("title")
+
+		client := jira.NewClient()
+		version, err := client.FindVersion(project, versionName)
+		if err != nil {
+			return err
+		}
+
+		jql := fmt.Sprintf("project = %s AND fixVersion = %s ORDER BY issuetype, key", project, version.ID)
+		issues, err := client.SearchAllIssues(jql, []string{"summary", "issuetype", "status"}, nil)
+		if err != nil {
+			return fmt.Errorf("failed to get version issues: %w", err)
+		}
+
+		if title == "" {
+			title = fmt.Sprintf("Release notes - %s %s", project, version.Name)
+		}
+		groups := groupByIssueType(issues)
+
+		if space != "" {
+			if viper.GetString("confluence_token") == "" || viper.GetString("confluence_base_url") == "" {
+				return fmt.Errorf("CONFLUENCE_TOKEN and CONFLUENCE_BASE_URL are required to publish")
+			}
+
+			page, err := confluence.NewClient().CreatePage(space, title, renderReleaseNotes("confluence", title, version, groups), parentID)
+			if err != nil {
+				return fmt.Errorf("failed to publish release notes: %w", err)
+			}
+
+			fmt.Printf("Release notes published!\n")
+			fmt.Printf("ID: %s\n", page.ID)
+			fmt.Printf("URL: %s%s\n", viper.GetString("confluence_base_url"), page.Links.WebUI)
+			return nil
+		}
+
+		switch format {
+		case "md", "html", "confluence":
+		default:
+			return fmt.Errorf("unsupported format: %s (use md, html or confluence)", format)
+		}
+
+		fmt.Print(renderReleaseNotes(format, title, version, groups))
+		return nil
+	},
+}
+
+func init() {
+	Cmd.AddCommand(releaseNotesCmd)
+
+	releaseNotesCmd.Flags().String("format", "md", "Output format: md, html, confluence")
+	releaseNotesCmd.Flags().String("publish-space", "", "Publish the notes as a page in this Confluence space")
+	releaseNotesCmd.Flags().String("parent", "", "Parent page ID when publishing")
+	releaseNotesCmd.Flags().String("title", "", "Title of the notes (default: \"Release notes - PROJECT VERSION\")")
+}
+
+type issueGroup struct {
+	name   string
+	issues []jira.Issue
+}
+
+func groupByIssueType(issues []jira.Issue) []issueGroup {
+	index := make(map[string]int)
+	var groups []issueGroup
+	for _, issue := range issues {
+		name := issue.Fields.IssueType.Name
+		i, ok := index[name]
+		if !ok {
+			i = len(groups)
+			index[name] = i
+			groups = append(groups, issueGroup{name: name})
+		}
+		groups[i].issues = append(groups[i].issues, issue)
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].name < groups[j].name
+	})
+	return groups
+}
+
+func renderReleaseNotes(format, title string, version *jira.Version, groups []issueGroup) string {
+	baseURL := strings.TrimSuffix(viper.GetString("jira_base_url"), "/")
+
+	var sb strings.Builder
+	switch format {
+	case "md":
+		fmt.Fprintf(&sb, "# %s\n\n", title)
+		if version.ReleaseDate != "" {
+			fmt.Fprintf(&sb, "Release date: %s\n\n", version.ReleaseDate)
+		}
+		if version.Description != "" {
+			fmt.Fprintf(&sb, "%s\n\n", version.Description)
+		}
+		for _, g := range groups {
+			fmt.Fprintf(&sb, "## %s\n\n", g.name)
+			for _, issue := range g.issues {
+				fmt.Fprintf(&sb, "- [%s](%s/browse/%s) %s\n", issue.Key, baseURL, issue.Key, issue.Fields.Summary)
+			}
+			sb.WriteString("\n")
+		}
+	case "html", "confluence":
+		if format == "html" {
+			fmt.Fprintf(&sb, "<h1>%s</h1>\n", html.EscapeString(title))
+		}
+		if version.ReleaseDate != "" {
+			fmt.Fprintf(&sb, "<p>Release date: %s</p>\n", html.EscapeString(version.ReleaseDate))
+		}
+		if version.Description != "" {
+			fmt.Fprintf(&sb, "<p>%s</p>\n", html.EscapeString(version.Description))
+		}
+		for _, g := range groups {
+			fmt.Fprintf(&sb, "<h2>%s</h2>\n<ul>\n", html.EscapeString(g.name))
+			for _, issue := range g.issues {
+				summary := html.EscapeString(issue.Fields.Summary)
+				if format == "confluence" {
+					fmt.Fprintf(&sb, "<li><ac:structured-macro ac:name=\"jira\"><ac:parameter ac:name=\"key\">%s</ac:parameter></ac:structured-macro> %s</li>\n", issue.Key, summary)
+				} else {
+					fmt.Fprintf(&sb, "<li><a href=\"%s/browse/%s\">%s</a> %s</li>\n", baseURL, issue.Key, issue.Key, summary)
+				}
+			}
+			sb.WriteString("</ul>\n")
+		}
+	}
+	return sb.String()
+}
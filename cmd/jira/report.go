@@ -0,0 +1,223 @@
+package jira
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Agile reports computed from issue changelogs",
+	Long: `Compute sprint reports and velocity from issue changelogs.
+
+Report commands support -o text, json and csv.`,
+}
+
+var reportVelocityCmd = &cobra.Command{
+	Use:   "velocity",
+	Short: "Committed vs completed story points per closed sprint",
+	Long: `Compute committed and completed story points for the last closed sprints of a board.
+
+Committed points are the points of the issues that were in the sprint when it started,
+reconstructed from each issue's changelog. Completed points are the points of the issues
+in a done status category when the sprint was closed.
+
+Examples:
+  atlassian jira report velocity -b 12 --sprints 6
+  atlassian jira report velocity -b 12 -o csv > velocity.csv`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		count, _ := cmd.Flags().GetInt("sprints")
+		pointsField, _ := cmd.Flags().GetString("points-field")
+		includeRemoved, _ := cmd.Flags().GetBool("include-removed")
+
+		boardID, err := boardFlag(cmd)
+		if err != nil {
+			return err
+		}
+
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+		field, err := client.FindField(pointsField)
+		if err != nil {
+			return fmt.Errorf("failed to resolve story points field: %w", err)
+		}
+
+		entries, err := client.GetVelocity(boardID, count, jira.ReportOptions{
+			PointsField:    field,
+			IncludeRemoved: includeRemoved,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to compute velocity: %w", err)
+		}
+
+		if output.Structured() {
+			return output.Print(entries)
+		}
+
+		table := output.NewTable("Sprint ID", "Name", "Start", "End", "Committed", "Completed")
+		table.Empty = "No closed sprints found"
+		var completed float64
+		for _, e := range entries {
+			table.AddRow(
+				strconv.Itoa(e.SprintID),
+				e.SprintName,
+				shortDate(e.StartDate),
+				shortDate(e.EndDate),
+				formatPoints(e.CommittedPoints),
+				formatPoints(e.CompletedPoints),
+			)
+			completed += e.CompletedPoints
+		}
+		if len(entries) > 0 {
+			table.Footer = fmt.Sprintf("Average velocity: %.1f points over %d sprints", completed/float64(len(entries)), len(entries))
+		}
+		return table.Print()
+	},
+}
+
+var reportSprintCmd = &cobra.Command{
+	Use:   "sprint [sprint-id]",
+	Short: "Sprint report: completed, not completed, added and removed issues",
+	Long: `List the issues completed, not completed and removed during a sprint.
+
+Issues added after the sprint started are flagged as added mid-sprint.
+
+Examples:
+  atlassian jira report sprint 345
+  atlassian jira report sprint 345 -o csv`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		sprintID, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid sprint ID: %s", args[0])
+		}
+		pointsField, _ := cmd.Flags().GetString("points-field")
+
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+		field, err := client.FindField(pointsField)
+		if err != nil {
+			return fmt.Errorf("failed to resolve story points field: %w", err)
+		}
+
+		sprint, err := client.GetSprint(sprintID)
+		if err != nil {
+			return fmt.Errorf("failed to get sprint: %w", err)
+		}
+
+		report, err := client.GetSprintReport(sprint, jira.ReportOptions{
+			PointsField:    field,
+			IncludeRemoved: true,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to compute sprint report: %w", err)
+		}
+
+		if output.Structured() {
+			return output.Print(report)
+		}
+		if !output.Human() {
+			table := output.NewTable(append([]string{"Category"}, sprintReportHeader...)...)
+			table.Rows = append(table.Rows, sprintReportRows("completed", report.Completed)...)
+			table.Rows = append(table.Rows, sprintReportRows("not completed", report.NotCompleted)...)
+			table.Rows = append(table.Rows, sprintReportRows("removed", report.Removed)...)
+			return table.Print()
+		}
+
+		fmt.Printf("## %s (%s)\n\n", report.Sprint.Name, report.Sprint.State)
+		fmt.Printf("Committed: %s | Completed: %s | Added: %s | Removed: %s\n",
+			formatPoints(report.CommittedPoints),
+			formatPoints(report.CompletedPoints),
+			formatPoints(report.AddedPoints),
+			formatPoints(report.RemovedPoints),
+		)
+
+		var added []jira.SprintReportIssue
+		for _, group := range [][]jira.SprintReportIssue{report.Completed, report.NotCompleted, report.Removed} {
+			for _, issue := range group {
+				if issue.Added {
+					added = append(added, issue)
+				}
+			}
+		}
+
+		sections := []struct {
+			title  string
+			issues []jira.SprintReportIssue
+		}{
+			{"Completed Issues", report.Completed},
+			{"Issues Not Completed", report.NotCompleted},
+			{"Issues Removed From Sprint", report.Removed},
+			{"Issues Added Mid-Sprint", added},
+		}
+		for _, s := range sections {
+			if err := printSprintReportSection(s.title, s.issues); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	Cmd.AddCommand(reportCmd)
+	reportCmd.AddCommand(reportVelocityCmd)
+	reportCmd.AddCommand(reportSprintCmd)
+
+	reportCmd.PersistentFlags().String("points-field", "Story Points", "Story points field name or ID")
+
+	reportVelocityCmd.Flags().IntP("board", "b", 0, "Board ID (defaults to the project's default board)")
+	reportVelocityCmd.Flags().StringP("project", "p", "", "Project whose default board to use")
+	reportVelocityCmd.Flags().Int("sprints", 6, "Number of closed sprints to include")
+	reportVelocityCmd.Flags().Bool("include-removed", true, "Count issues removed mid-sprint in the commitment (--include-removed=false is faster)")
+}
+
+var sprintReportHeader = []string{"Key", "Type", "Status", "SP", "Added", "Summary"}
+
+func sprintReportRows(category string, issues []jira.SprintReportIssue) [][]string {
+	rows := make([][]string, 0, len(issues))
+	for _, issue := range issues {
+		added := "No"
+		if issue.Added {
+			added = "Yes"
+		}
+		row := []string{issue.Key, issue.Type, issue.Status, formatPoints(issue.Points), added, issue.Summary}
+		if category != "" {
+			row = append([]string{category}, row...)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func printSprintReportSection(title string, issues []jira.SprintReportIssue) error {
+	fmt.Println()
+	table := output.NewTable(sprintReportHeader...)
+	table.Title = fmt.Sprintf("### %s (%d)", title, len(issues))
+	table.Rows = sprintReportRows("", issues)
+	table.Empty = "None"
+	return table.Print()
+}
+
+func formatPoints(points float64) string {
+	return strconv.FormatFloat(points, 'f', -1, 64)
+}
+
+func shortDate(date string) string {
+	if date == "" {
+		return "-"
+	}
+	if len(date) > 10 {
+		return date[:10]
+	}
+	return date
+}
@@ -1,72 +1,148 @@
 package jira
 
 import (
-	"encoding/json"
 	"fmt"
+	"os"
+	"strings"
 
+	"github.com/joselrodrigues/atlassian/internal/config"
 	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 var searchCmd = &cobra.Command{
-	Use:   "search [jql]",
+	Use:   "search [jql | @query [name=value...]]",
 	Short: "Search issues using JQL",
-	Long:  `Search for Jira issues using JQL (Jira Query Language).`,
-	Args:  cobra.ExactArgs(1),
+	Long: `Search for Jira issues using JQL (Jira Query Language), run a saved query
+with @name followed by values for its placeholders, or run a Jira filter with --filter.
+
+Structured flags are compiled into JQL and combined with the query using AND:
+
+  atlassian jira search --project PROJ --status "In Progress" --assignee me --updated -7d
+  atlassian jira search @triage --label backend --print-jql`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		jql := args[0]
 		maxResults, _ := cmd.Flags().GetInt("max")
+		filterRef, _ := cmd.Flags().GetString("filter")
+		printOnly, _ := cmd.Flags().GetBool("print-jql")
 
 		client := jira.NewClient()
-		result, err := client.SearchIssues(jql, maxResults)
+
+		var jql string
+		if filterRef != "" {
+			if len(args) > 0 {
+				return fmt.Errorf("--filter cannot be combined with a JQL argument")
+			}
+			if err := client.DetectInstanceType(); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+			}
+			filter, err := client.FindFilter(filterRef)
+			if err != nil {
+				return fmt.Errorf("failed to get filter: %w", err)
+			}
+			jql = filter.JQL
+		} else if len(args) > 0 {
+			var err error
+			if jql, err = resolveJQL(args); err != nil {
+				return err
+			}
+		}
+
+		criteria, err := searchCriteria(cmd, client)
 		if err != nil {
-			return fmt.Errorf("search failed: %w", err)
+			return err
+		}
+		if jql, err = criteria.Apply(jql); err != nil {
+			return err
+		}
+		if strings.TrimSpace(jql) == "" {
+			return fmt.Errorf("a JQL query, @query, --filter or search flags are required")
 		}
 
-		if viper.GetString("output") == "json" {
-			data, _ := json.MarshalIndent(result, "", "  ")
-			fmt.Println(string(data))
+		if printOnly {
+			fmt.Println(jql)
 			return nil
 		}
 
-		printSearchResults(result)
-		return nil
-	},
-}
+		columns, err := issueColumns(cmd, client)
+		if err != nil {
+			return err
+		}
 
-func init() {
-	Cmd.AddCommand(searchCmd)
-	searchCmd.Flags().IntP("max", "m", 50, "Maximum results to return")
+		result, err := client.SearchIssuesWithFields(jql, issueFields(columns), maxResults)
+		if err != nil {
+			return fmt.Errorf("search failed: %w", err)
+		}
+
+		if output.Structured() {
+			return output.PrintList(result, result.Issues)
+		}
+
+		return printSearchResults(result, columns)
+	},
 }
 
-func printSearchResults(result *jira.SearchResult) {
-	fmt.Printf("Found %d issues:\n\n", result.Total)
-	fmt.Printf("| Key | Status | SP | Assignee | Summary |\n")
-	fmt.Printf("|-----|--------|-----|----------|--------|\n")
+func searchCriteria(cmd *cobra.Command, client *jira.Client) (jira.SearchCriteria, error) {
+	var criteria jira.SearchCriteria
+	criteria.Projects, _ = cmd.Flags().GetStringSlice("project")
+	criteria.Statuses, _ = cmd.Flags().GetStringSlice("status")
+	criteria.Labels, _ = cmd.Flags().GetStringSlice("label")
+	criteria.Sprint, _ = cmd.Flags().GetString("sprint")
+	criteria.Updated, _ = cmd.Flags().GetString("updated")
+	criteria.Text, _ = cmd.Flags().GetString("text")
 
-	for _, issue := range result.Issues {
-		assignee := "Unassigned"
-		if issue.Fields.Assignee != nil {
-			assignee = issue.Fields.Assignee.DisplayName
+	assignees, _ := cmd.Flags().GetStringSlice("assignee")
+	detected := false
+	for _, a := range assignees {
+		switch strings.ToLower(a) {
+		case "me", "none", "unassigned", "currentuser()":
+			criteria.Assignees = append(criteria.Assignees, a)
+			continue
 		}
 
-		sp := "-"
-		if issue.Fields.StoryPoints > 0 {
-			sp = fmt.Sprintf("%.0f", issue.Fields.StoryPoints)
+		if !detected {
+			if err := client.DetectInstanceType(); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+			}
+			detected = true
 		}
-
-		summary := issue.Fields.Summary
-		if len(summary) > 50 {
-			summary = summary[:47] + "..."
+		id, err := resolveUserID(client, a)
+		if err != nil {
+			return criteria, err
 		}
+		criteria.Assignees = append(criteria.Assignees, id)
+	}
+
+	return criteria, nil
+}
 
-		fmt.Printf("| %s | %s | %s | %s | %s |\n",
-			issue.Key,
-			issue.Fields.Status.Name,
-			sp,
-			assignee,
-			summary,
-		)
+func resolveJQL(args []string) (string, error) {
+	if strings.HasPrefix(args[0], "@") {
+		return config.ExpandQuery(args[0], args[1:])
 	}
+	if len(args) > 1 {
+		return "", fmt.Errorf("expected a single JQL argument (quote it) or @query name=value")
+	}
+	return args[0], nil
+}
+
+func init() {
+	Cmd.AddCommand(searchCmd)
+	searchCmd.Flags().IntP("max", "m", 50, "Maximum results to return")
+	searchCmd.Flags().String("filter", "", "Run the JQL of a Jira filter (ID or name)")
+	searchCmd.Flags().StringSliceP("project", "p", nil, "Only these projects")
+	searchCmd.Flags().StringSlice("status", nil, "Only these statuses")
+	searchCmd.Flags().StringSlice("assignee", nil, "Only these assignees (me, none, or a user)")
+	searchCmd.Flags().StringSlice("label", nil, "Only issues with any of these labels")
+	searchCmd.Flags().String("sprint", "", "Sprint: current, future, closed, none, an ID or a name")
+	searchCmd.Flags().String("updated", "", "Updated since a date (YYYY-MM-DD) or period (-7d, -2w)")
+	searchCmd.Flags().String("text", "", "Full-text search in summary, description and comments")
+	searchCmd.Flags().Bool("print-jql", false, "Print the resulting JQL instead of searching")
+	searchCmd.Flags().StringSlice("columns", nil, "Columns to show, by field name or ID (e.g. key,status,labels,\"Story Points\")")
+}
+
+func printSearchResults(result *jira.SearchResult, columns []jira.Column) error {
+	table := issueTable(columns, result.Issues)
+	table.Title = fmt.Sprintf("Found %d issues:", result.Total)
+	return table.Print()
 }
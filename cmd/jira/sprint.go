@@ -1,12 +1,11 @@
 package jira
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 var sprintCmd = &cobra.Command{
@@ -14,7 +13,10 @@ var sprintCmd = &cobra.Command{
 	Short: "List issues in current sprint",
 	Long:  `List all issues in the current active sprint for a project.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		project, _ := cmd.Flags().GetString("project")
+		project, err := projectFlag(cmd)
+		if err != nil {
+			return err
+		}
 
 		client := jira.NewClient()
 		result, err := client.GetSprintIssues(project)
@@ -22,20 +24,17 @@ var sprintCmd = &cobra.Command{
 			return fmt.Errorf("failed to get sprint issues: %w", err)
 		}
 
-		if viper.GetString("output") == "json" {
-			data, _ := json.MarshalIndent(result, "", "  ")
-			fmt.Println(string(data))
-			return nil
+		if output.Structured() {
+			return output.PrintList(result, result.Issues)
 		}
 
-		fmt.Printf("Sprint issues for project %s:\n\n", project)
-		printSearchResults(result)
-		return nil
+		table := issueTable(nil, result.Issues)
+		table.Title = fmt.Sprintf("Sprint issues for project %s (%d):", project, result.Total)
+		return table.Print()
 	},
 }
 
 func init() {
 	Cmd.AddCommand(sprintCmd)
-	sprintCmd.Flags().StringP("project", "p", "", "Project key (required)")
-	sprintCmd.MarkFlagRequired("project")
+	sprintCmd.Flags().StringP("project", "p", "", "Project key (defaults to the configured project)")
 }
@@ -1,12 +1,12 @@
 package jira
 
 import (
-	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 var sprintsCmd = &cobra.Command{
@@ -14,12 +14,11 @@ var sprintsCmd = &cobra.Command{
 	Short: "List sprints for a board",
 	Long:  `List all sprints for a specific board, optionally filtered by state.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		boardID, _ := cmd.Flags().GetInt("board")
 		state, _ := cmd.Flags().GetString("state")
-		output := viper.GetString("output")
 
-		if boardID == 0 {
-			return fmt.Errorf("--board is required")
+		boardID, err := boardFlag(cmd)
+		if err != nil {
+			return err
 		}
 
 		client := jira.NewClient()
@@ -28,45 +27,23 @@ var sprintsCmd = &cobra.Command{
 			return fmt.Errorf("failed to get sprints: %w", err)
 		}
 
-		if output == "json" {
-			jsonData, err := json.MarshalIndent(result.Values, "", "  ")
-			if err != nil {
-				return fmt.Errorf("failed to marshal JSON: %w", err)
-			}
-			fmt.Println(string(jsonData))
-			return nil
-		}
-
-		if len(result.Values) == 0 {
-			fmt.Println("No sprints found")
-			return nil
+		if output.Structured() {
+			return output.Print(result.Values)
 		}
 
-		fmt.Println("| Sprint ID | Name | State | Start Date | End Date |")
-		fmt.Println("| --------- | ---- | ----- | ---------- | -------- |")
+		table := output.NewTable("Sprint ID", "Name", "State", "Start Date", "End Date")
+		table.Empty = "No sprints found"
 		for _, sprint := range result.Values {
-			startDate := sprint.StartDate
-			if startDate == "" {
-				startDate = "-"
-			} else if len(startDate) > 10 {
-				startDate = startDate[:10]
-			}
-			endDate := sprint.EndDate
-			if endDate == "" {
-				endDate = "-"
-			} else if len(endDate) > 10 {
-				endDate = endDate[:10]
-			}
-			fmt.Printf("| %d | %s | %s | %s | %s |\n", sprint.ID, sprint.Name, sprint.State, startDate, endDate)
+			table.AddRow(strconv.Itoa(sprint.ID), sprint.Name, sprint.State, shortDate(sprint.StartDate), shortDate(sprint.EndDate))
 		}
-
-		return nil
+		return table.Print()
 	},
 }
 
 func init() {
 	Cmd.AddCommand(sprintsCmd)
 
-	sprintsCmd.Flags().IntP("board", "b", 0, "Board ID (required)")
+	sprintsCmd.Flags().IntP("board", "b", 0, "Board ID (defaults to the project's default board)")
+	sprintsCmd.Flags().StringP("project", "p", "", "Project whose default board to use")
 	sprintsCmd.Flags().StringP("state", "s", "", "Filter by state (active, future, closed)")
 }
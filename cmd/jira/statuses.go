@@ -0,0 +1,71 @@
+package jira
+
+import (
+	"fmt"
+
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var statusesCmd = &cobra.Command{
+	Use:   "statuses",
+	Short: "List statuses and their categories",
+	Long: `List the statuses available to each issue type of a project, with their status
+category (To Do, In Progress or Done). Without a project all statuses are listed.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		project, _ := cmd.Flags().GetString("project")
+		if project == "" {
+			project = viper.GetString("jira_project")
+		}
+
+		client := jira.NewClient()
+
+		if project == "" {
+			statuses, err := client.GetStatuses()
+			if err != nil {
+				return fmt.Errorf("failed to get statuses: %w", err)
+			}
+
+			if output.Structured() {
+				return output.Print(statuses)
+			}
+
+			table := output.NewTable("ID", "Status", "Category")
+			for _, s := range statuses {
+				table.AddRow(s.ID, s.Name, categoryName(s))
+			}
+			return table.Print()
+		}
+
+		types, err := client.GetProjectStatuses(project)
+		if err != nil {
+			return fmt.Errorf("failed to get statuses: %w", err)
+		}
+
+		if output.Structured() {
+			return output.Print(types)
+		}
+
+		table := output.NewTable("Issue Type", "ID", "Status", "Category")
+		for _, t := range types {
+			for _, s := range t.Statuses {
+				table.AddRow(t.Name, s.ID, s.Name, categoryName(s))
+			}
+		}
+		return table.Print()
+	},
+}
+
+func categoryName(s jira.Status) string {
+	if s.StatusCategory == nil {
+		return "-"
+	}
+	return s.StatusCategory.Name
+}
+
+func init() {
+	Cmd.AddCommand(statusesCmd)
+	statusesCmd.Flags().StringP("project", "p", "", "Project key (defaults to the configured project)")
+}
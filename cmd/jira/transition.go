@@ -1,12 +1,13 @@
 package jira
 
 import (
-	"encoding/json"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 var transitionCmd = &cobra.Command{
@@ -28,20 +29,20 @@ var transitionListCmd = &cobra.Command{
 			return fmt.Errorf("failed to get transitions: %w", err)
 		}
 
-		if viper.GetString("output") == "json" {
-			data, _ := json.MarshalIndent(transitions, "", "  ")
-			fmt.Println(string(data))
-			return nil
+		if output.Structured() {
+			return output.PrintList(transitions, transitions.Transitions)
 		}
 
-		fmt.Printf("Available transitions for %s:\n\n", issueKey)
-		fmt.Printf("| ID | Name | To Status |\n")
-		fmt.Printf("|----|------|----------|\n")
+		table := output.NewTable("ID", "Name", "To Status", "Required Fields")
+		table.Title = fmt.Sprintf("Available transitions for %s:", issueKey)
 		for _, t := range transitions.Transitions {
-			fmt.Printf("| %s | %s | %s |\n", t.ID, t.Name, t.To.Name)
+			var required []string
+			for _, id := range t.RequiredFields() {
+				required = append(required, t.Fields[id].Name)
+			}
+			table.AddRow(t.ID, t.Name, t.To.Name, joinOrDash(required))
 		}
-
-		return nil
+		return table.Print()
 	},
 }
 
@@ -53,8 +54,28 @@ var transitionDoCmd = &cobra.Command{
 		issueKey := args[0]
 		transition := args[1]
 
+		resolution, _ := cmd.Flags().GetString("resolution")
+		comment, _ := cmd.Flags().GetString("comment")
+		fieldExprs, _ := cmd.Flags().GetStringArray("field")
+
+		opts := jira.TransitionOptions{
+			Resolution: resolution,
+			Comment:    comment,
+			Fields:     make(map[string]string),
+		}
+		for _, expr := range fieldExprs {
+			name, value, ok := strings.Cut(expr, "=")
+			if !ok || strings.TrimSpace(name) == "" {
+				return fmt.Errorf("invalid field '%s', expected name=value", expr)
+			}
+			opts.Fields[strings.TrimSpace(name)] = strings.TrimSpace(value)
+		}
+
 		client := jira.NewClient()
-		if err := client.DoTransition(issueKey, transition); err != nil {
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+		if err := client.DoTransitionWithOptions(issueKey, transition, opts); err != nil {
 			return fmt.Errorf("failed to transition: %w", err)
 		}
 
@@ -67,4 +88,8 @@ func init() {
 	Cmd.AddCommand(transitionCmd)
 	transitionCmd.AddCommand(transitionListCmd)
 	transitionCmd.AddCommand(transitionDoCmd)
+
+	transitionDoCmd.Flags().String("resolution", "", "Resolution to set (e.g. Fixed)")
+	transitionDoCmd.Flags().String("comment", "", "Comment to add with the transition")
+	transitionDoCmd.Flags().StringArray("field", nil, "Field to set on the transition screen as name=value (repeatable)")
 }
@@ -58,19 +58,9 @@ Supports updating summary, description, assignee, story points, and sprint.`,
 		}
 
 		if assignee != "" {
-			var userID string
-			if strings.Contains(assignee, "@") {
-				users, err := client.SearchUsers(assignee)
-				if err != nil {
-					return fmt.Errorf("failed to search for user: %w", err)
-				}
-				if len(users) == 0 {
-					return fmt.Errorf("no user found with email: %s", assignee)
-				}
-				userID = users[0].GetIdentifier(client.IsCloud())
-				fmt.Printf("Found user: %s (%s)\n", users[0].DisplayName, userID)
-			} else {
-				userID = assignee
+			userID, err := resolveAssigneeID(client, issueKey, assignee)
+			if err != nil {
+				return err
 			}
 
 			if err := client.AssignIssue(issueKey, userID); err != nil {
@@ -110,7 +100,7 @@ func init() {
 	updateCmd.Flags().StringP("summary", "s", "", "New summary")
 	updateCmd.Flags().StringP("description", "d", "", "New description")
 	updateCmd.Flags().Bool("stdin", false, "Read description from stdin")
-	updateCmd.Flags().StringP("assignee", "a", "", "Assign to user (email or accountId)")
+	updateCmd.Flags().StringP("assignee", "a", "", "Assign to user (email, name, username, accountId or 'me')")
 	updateCmd.Flags().Float64("points", 0, "Story points")
 	updateCmd.Flags().Int("sprint", 0, "Sprint ID to move issue to")
 	updateCmd.Flags().String("points-field", "customfield_10106", "Custom field ID for story points")
@@ -1,61 +1,73 @@
 package jira
 
 import (
-	"encoding/json"
+	"bufio"
+	"errors"
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
 
 	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 var usersCmd = &cobra.Command{
 	Use:   "users",
 	Short: "Search for users",
-	Long:  `Search for users by name or email to get their accountId.`,
+	Long: `Search for users by name or email to get their identifier (Server: name, Cloud: accountId).
+
+Use --assignable with --project or --issue to only list users that can be assigned,
+or --group to list the members of a group.
+
+Examples:
+  atlassian jira users -q john
+  atlassian jira users --assignable -p PROJ
+  atlassian jira users --assignable --issue PROJ-1 -q john
+  atlassian jira users --group jira-developers`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		query, _ := cmd.Flags().GetString("query")
-		output := viper.GetString("output")
-
-		if query == "" {
-			return fmt.Errorf("--query is required")
-		}
+		assignable, _ := cmd.Flags().GetBool("assignable")
+		project, _ := cmd.Flags().GetString("project")
+		issueKey, _ := cmd.Flags().GetString("issue")
+		group, _ := cmd.Flags().GetString("group")
+		includeInactive, _ := cmd.Flags().GetBool("include-inactive")
 
 		client := jira.NewClient()
-		users, err := client.SearchUsers(query)
-		if err != nil {
-			return fmt.Errorf("failed to search users: %w", err)
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
 		}
 
-		if output == "json" {
-			jsonData, err := json.MarshalIndent(users, "", "  ")
-			if err != nil {
-				return fmt.Errorf("failed to marshal JSON: %w", err)
+		var users []jira.UserSearchResult
+		var err error
+		switch {
+		case group != "":
+			users, err = client.GetGroupMembers(group, includeInactive)
+		case assignable:
+			if project == "" && issueKey == "" {
+				return fmt.Errorf("--assignable requires --project or --issue")
 			}
-			fmt.Println(string(jsonData))
-			return nil
+			users, err = client.SearchAssignableUsers(query, project, issueKey)
+		case query == "":
+			return fmt.Errorf("--query is required")
+		default:
+			users, err = client.SearchUsers(query)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to search users: %w", err)
 		}
 
-		if len(users) == 0 {
-			fmt.Println("No users found")
-			return nil
+		if output.Structured() {
+			return output.Print(users)
 		}
 
-		fmt.Println("| Account ID | Display Name | Email | Active |")
-		fmt.Println("| ---------- | ------------ | ----- | ------ |")
+		table := output.NewTable("Identifier", "Display Name", "Email", "Active")
+		table.Empty = "No users found"
 		for _, user := range users {
-			email := user.EmailAddress
-			if email == "" {
-				email = "-"
-			}
-			active := "Yes"
-			if !user.Active {
-				active = "No"
-			}
-			fmt.Printf("| %s | %s | %s | %s |\n", user.AccountID, user.DisplayName, email, active)
+			table.AddRow(user.GetIdentifier(client.IsCloud()), user.DisplayName, valueOrDash(user.EmailAddress), yesNo(user.Active))
 		}
-
-		return nil
+		return table.Print()
 	},
 }
 
@@ -63,4 +75,75 @@ func init() {
 	Cmd.AddCommand(usersCmd)
 
 	usersCmd.Flags().StringP("query", "q", "", "Search query (name or email)")
+	usersCmd.Flags().Bool("assignable", false, "Only users assignable in --project or to --issue")
+	usersCmd.Flags().StringP("project", "p", "", "Project key for --assignable")
+	usersCmd.Flags().String("issue", "", "Issue key for --assignable")
+	usersCmd.Flags().String("group", "", "List the members of a group")
+	usersCmd.Flags().Bool("include-inactive", false, "Include inactive users in --group")
+}
+
+func resolveUserID(client *jira.Client, userInput string) (string, error) {
+	return resolveWith(jira.NewUserResolver(client), client, userInput)
+}
+
+func resolveAssigneeID(client *jira.Client, issueKey, userInput string) (string, error) {
+	resolver := jira.NewUserResolver(client)
+	resolver.AssignableIssue = issueKey
+	return resolveWith(resolver, client, userInput)
+}
+
+func resolveWith(resolver *jira.UserResolver, client *jira.Client, userInput string) (string, error) {
+	if isTerminal() {
+		resolver.Prompt = promptUser
+	}
+
+	user, err := resolver.Resolve(userInput)
+	if err != nil {
+		var ambiguous *jira.AmbiguousUserError
+		if errors.As(err, &ambiguous) {
+			return "", fmt.Errorf("%w (use a more specific email, username or accountId)", err)
+		}
+		return "", err
+	}
+
+	userID := user.GetIdentifier(client.IsCloud())
+	if userID != userInput {
+		fmt.Fprintf(os.Stderr, "Found user: %s (%s)\n", user.DisplayName, userID)
+	}
+	return userID, nil
+}
+
+func isTerminal() bool {
+	info, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+func promptUser(input string, candidates []jira.UserSearchResult) (*jira.UserSearchResult, error) {
+	fmt.Fprintf(os.Stderr, "Multiple users match '%s':\n", input)
+	for i, u := range candidates {
+		email := u.EmailAddress
+		if email == "" {
+			email = "-"
+		}
+		id := u.AccountID
+		if id == "" {
+			id = u.Name
+		}
+		fmt.Fprintf(os.Stderr, "  %d) %s <%s> (%s)\n", i+1, u.DisplayName, email, id)
+	}
+	fmt.Fprintf(os.Stderr, "Select a user [1-%d]: ", len(candidates))
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return nil, fmt.Errorf("failed to read selection: %w", err)
+	}
+
+	choice, err := strconv.Atoi(strings.TrimSpace(line))
+	if err != nil || choice < 1 || choice > len(candidates) {
+		return nil, fmt.Errorf("invalid selection: %s", strings.TrimSpace(line))
+	}
+	return &candidates[choice-1], nil
 }
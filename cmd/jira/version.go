@@ -0,0 +1,225 @@
+package jira
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var versionsCmd = &cobra.Command{
+	Use:   "versions",
+	Short: "List project versions",
+	Long:  `List the versions (releases) of a project. Archived versions are hidden unless --archived is set.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		showArchived, _ := cmd.Flags().GetBool("archived")
+
+		project, err := projectFlag(cmd)
+		if err != nil {
+			return err
+		}
+
+		client := jira.NewClient()
+		versions, err := client.GetProjectVersions(project)
+		if err != nil {
+			return fmt.Errorf("failed to get versions: %w", err)
+		}
+
+		var filtered []jira.Version
+		for _, v := range versions {
+			if v.Archived && !showArchived {
+				continue
+			}
+			filtered = append(filtered, v)
+		}
+
+		if output.Structured() {
+			return output.Print(filtered)
+		}
+
+		table := output.NewTable("ID", "Name", "Released", "Archived", "Release Date")
+		table.Empty = "No versions found"
+		for _, v := range filtered {
+			table.AddRow(v.ID, v.Name, yesNo(v.Released), yesNo(v.Archived), shortDate(v.ReleaseDate))
+		}
+		return table.Print()
+	},
+}
+
+var versionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Manage project versions",
+	Long:  `Create, release, archive and merge project versions.`,
+}
+
+var versionCreateCmd = &cobra.Command{
+	Use:   "create [name]",
+	Short: "Create a version",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		description, _ := cmd.Flags().GetString("description")
+		startDate, _ := cmd.Flags().GetString("start-date")
+		releaseDate, _ := cmd.Flags().GetString("release-date")
+
+		project, err := projectFlag(cmd)
+		if err != nil {
+			return err
+		}
+
+		client := jira.NewClient()
+		version, err := client.CreateVersion(project, jira.CreateVersionRequest{
+			Name:        args[0],
+			Description: description,
+			StartDate:   startDate,
+			ReleaseDate: releaseDate,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create version: %w", err)
+		}
+
+		if output.Structured() {
+			return output.Print(version)
+		}
+
+		fmt.Printf("Version %s created in %s (ID: %s)\n", version.Name, project, version.ID)
+		return nil
+	},
+}
+
+var versionReleaseCmd = &cobra.Command{
+	Use:   "release [name-or-id]",
+	Short: "Release a version",
+	Long: `Mark a version as released.
+
+Use --move-unresolved-to to move the unresolved issues of the version to another version first.
+
+Examples:
+  atlassian jira version release 2.4.0 -p PROJ
+  atlassian jira version release 2.4.0 -p PROJ --move-unresolved-to 2.5.0`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		date, _ := cmd.Flags().GetString("date")
+		moveTo, _ := cmd.Flags().GetString("move-unresolved-to")
+		if date == "" {
+			date = time.Now().Format("2006-01-02")
+		}
+
+		project, err := projectFlag(cmd)
+		if err != nil {
+			return err
+		}
+
+		client := jira.NewClient()
+		version, err := client.FindVersion(project, args[0])
+		if err != nil {
+			return err
+		}
+
+		if moveTo != "" {
+			target, err := client.FindVersion(project, moveTo)
+			if err != nil {
+				return err
+			}
+			moved, err := client.MoveUnresolvedIssues(project, version, target)
+			if err != nil {
+				return fmt.Errorf("failed to move unresolved issues: %w", err)
+			}
+			fmt.Printf("Moved %d unresolved issues from %s to %s\n", len(moved), version.Name, target.Name)
+		}
+
+		if _, err := client.UpdateVersion(version.ID, map[string]interface{}{
+			"released":    true,
+			"releaseDate": date,
+		}); err != nil {
+			return fmt.Errorf("failed to release version: %w", err)
+		}
+
+		fmt.Printf("Version %s released (%s)\n", version.Name, date)
+		return nil
+	},
+}
+
+var versionArchiveCmd = &cobra.Command{
+	Use:   "archive [name-or-id]",
+	Short: "Archive a version",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		project, err := projectFlag(cmd)
+		if err != nil {
+			return err
+		}
+
+		client := jira.NewClient()
+		version, err := client.FindVersion(project, args[0])
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.UpdateVersion(version.ID, map[string]interface{}{"archived": true}); err != nil {
+			return fmt.Errorf("failed to archive version: %w", err)
+		}
+
+		fmt.Printf("Version %s archived\n", version.Name)
+		return nil
+	},
+}
+
+var versionMergeCmd = &cobra.Command{
+	Use:   "merge [from-version] [into-version]",
+	Short: "Merge a version into another",
+	Long:  `Move all issues of a version to another version and delete the merged version.`,
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		project, err := projectFlag(cmd)
+		if err != nil {
+			return err
+		}
+
+		client := jira.NewClient()
+		from, err := client.FindVersion(project, args[0])
+		if err != nil {
+			return err
+		}
+		into, err := client.FindVersion(project, args[1])
+		if err != nil {
+			return err
+		}
+
+		if err := client.MergeVersion(from.ID, into.ID); err != nil {
+			return fmt.Errorf("failed to merge version: %w", err)
+		}
+
+		fmt.Printf("Version %s merged into %s\n", from.Name, into.Name)
+		return nil
+	},
+}
+
+func init() {
+	Cmd.AddCommand(versionsCmd)
+	Cmd.AddCommand(versionCmd)
+	versionCmd.AddCommand(versionCreateCmd)
+	versionCmd.AddCommand(versionReleaseCmd)
+	versionCmd.AddCommand(versionArchiveCmd)
+	versionCmd.AddCommand(versionMergeCmd)
+
+	versionsCmd.Flags().StringP("project", "p", "", "Project key (defaults to the configured project)")
+	versionsCmd.Flags().Bool("archived", false, "Include archived versions")
+
+	versionCmd.PersistentFlags().StringP("project", "p", "", "Project key (defaults to the configured project)")
+
+	versionCreateCmd.Flags().StringP("description", "d", "", "Version description")
+	versionCreateCmd.Flags().String("start-date", "", "Start date (YYYY-MM-DD)")
+	versionCreateCmd.Flags().String("release-date", "", "Planned release date (YYYY-MM-DD)")
+
+	versionReleaseCmd.Flags().String("date", "", "Release date (YYYY-MM-DD, default today)")
+	versionReleaseCmd.Flags().String("move-unresolved-to", "", "Move unresolved issues to this version before releasing")
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "Yes"
+	}
+	return "No"
+}
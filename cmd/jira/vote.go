@@ -0,0 +1,59 @@
+package jira
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/spf13/cobra"
+)
+
+var voteCmd = &cobra.Command{
+	Use:   "vote [issue-key]",
+	Short: "Vote for an issue",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		issueKey := args[0]
+
+		client := jira.NewClient()
+		if err := client.AddVote(issueKey); err != nil {
+			return fmt.Errorf("failed to vote: %w", err)
+		}
+
+		fmt.Printf("Voted for %s\n", issueKey)
+		printVotes(client, issueKey)
+		return nil
+	},
+}
+
+var unvoteCmd = &cobra.Command{
+	Use:   "unvote [issue-key]",
+	Short: "Remove your vote from an issue",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		issueKey := args[0]
+
+		client := jira.NewClient()
+		if err := client.RemoveVote(issueKey); err != nil {
+			return fmt.Errorf("failed to remove vote: %w", err)
+		}
+
+		fmt.Printf("Vote removed from %s\n", issueKey)
+		printVotes(client, issueKey)
+		return nil
+	},
+}
+
+func init() {
+	Cmd.AddCommand(voteCmd)
+	Cmd.AddCommand(unvoteCmd)
+}
+
+func printVotes(client *jira.Client, issueKey string) {
+	votes, err := client.GetVotes(issueKey)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not get votes: %v\n", err)
+		return
+	}
+	fmt.Printf("%s has %d votes\n", issueKey, votes.Votes)
+}
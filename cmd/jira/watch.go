@@ -0,0 +1,115 @@
+package jira
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var watchCmd = &cobra.Command{
+	Use:   "watch [issue-key] [user]",
+	Short: "Start watching an issue",
+	Long: `Add a watcher to an issue. Without a user, the current user starts watching.
+The user can be an email, display name, username, accountId or "me".`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		issueKey := args[0]
+
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+
+		userID, err := watcherID(client, args)
+		if err != nil {
+			return err
+		}
+
+		if err := client.AddWatcher(issueKey, userID); err != nil {
+			return fmt.Errorf("failed to add watcher: %w", err)
+		}
+
+		fmt.Printf("%s is now watching %s\n", userID, issueKey)
+		return nil
+	},
+}
+
+var unwatchCmd = &cobra.Command{
+	Use:   "unwatch [issue-key] [user]",
+	Short: "Stop watching an issue",
+	Long: `Remove a watcher from an issue. Without a user, the current user stops watching.
+The user can be an email, display name, username, accountId or "me".`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		issueKey := args[0]
+
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+
+		userID, err := watcherID(client, args)
+		if err != nil {
+			return err
+		}
+
+		if err := client.RemoveWatcher(issueKey, userID); err != nil {
+			return fmt.Errorf("failed to remove watcher: %w", err)
+		}
+
+		fmt.Printf("%s is no longer watching %s\n", userID, issueKey)
+		return nil
+	},
+}
+
+var watchersCmd = &cobra.Command{
+	Use:   "watchers [issue-key]",
+	Short: "List the watchers of an issue",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		issueKey := args[0]
+
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+
+		watchers, err := client.GetWatchers(issueKey)
+		if err != nil {
+			return fmt.Errorf("failed to get watchers: %w", err)
+		}
+
+		if output.Structured() {
+			return output.PrintList(watchers, watchers.Watchers)
+		}
+
+		table := output.NewTable("Identifier", "Display Name", "Email")
+		table.Title = fmt.Sprintf("Watchers of %s (%d total):", issueKey, watchers.WatchCount)
+		table.Empty = "No watchers"
+		for _, user := range watchers.Watchers {
+			table.AddRow(user.GetIdentifier(client.IsCloud()), user.DisplayName, valueOrDash(user.EmailAddress))
+		}
+		return table.Print()
+	},
+}
+
+func init() {
+	Cmd.AddCommand(watchCmd)
+	Cmd.AddCommand(unwatchCmd)
+	Cmd.AddCommand(watchersCmd)
+}
+
+func watcherID(client *jira.Client, args []string) (string, error) {
+	if len(args) > 1 {
+		return resolveUserID(client, args[1])
+	}
+
+	user, err := client.GetCurrentUser()
+	if err != nil {
+		return "", fmt.Errorf("failed to get current user: %w", err)
+	}
+	return user.GetIdentifier(client.IsCloud()), nil
+}
@@ -1,12 +1,11 @@
 package jira
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 var whoamiCmd = &cobra.Command{
@@ -14,8 +13,6 @@ var whoamiCmd = &cobra.Command{
 	Short: "Show current authenticated user",
 	Long:  `Display information about the currently authenticated Jira user.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		output := viper.GetString("output")
-
 		client := jira.NewClient()
 		if err := client.DetectInstanceType(); err != nil {
 			return fmt.Errorf("failed to connect to Jira: %w", err)
@@ -26,13 +23,8 @@ var whoamiCmd = &cobra.Command{
 			return fmt.Errorf("failed to get current user: %w", err)
 		}
 
-		if output == "json" {
-			jsonData, err := json.MarshalIndent(user, "", "  ")
-			if err != nil {
-				return fmt.Errorf("failed to marshal JSON: %w", err)
-			}
-			fmt.Println(string(jsonData))
-			return nil
+		if output.Structured() {
+			return output.Print(user)
 		}
 
 		identifier := user.GetIdentifier(client.IsCloud())
@@ -41,16 +33,14 @@ var whoamiCmd = &cobra.Command{
 			instanceType = "Cloud"
 		}
 
-		fmt.Println("| Field | Value |")
-		fmt.Println("| ----- | ----- |")
-		fmt.Printf("| **Display Name** | %s |\n", user.DisplayName)
-		fmt.Printf("| **Identifier** | %s |\n", identifier)
+		table := output.NewTable("Field", "Value")
+		table.AddRow("Display Name", user.DisplayName)
+		table.AddRow("Identifier", identifier)
 		if user.EmailAddress != "" {
-			fmt.Printf("| **Email** | %s |\n", user.EmailAddress)
+			table.AddRow("Email", user.EmailAddress)
 		}
-		fmt.Printf("| **Instance Type** | %s |\n", instanceType)
-
-		return nil
+		table.AddRow("Instance Type", instanceType)
+		return table.Print()
 	},
 }
 
@@ -0,0 +1,197 @@
+package jira
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/joselrodrigues/atlassian/internal/jira"
+	"github.com/joselrodrigues/atlassian/internal/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var workflowCmd = &cobra.Command{
+	Use:   "workflow [project]",
+	Short: "Show the workflow of an issue type",
+	Long: `Show the statuses and transitions of the workflow used by an issue type.
+On Jira Cloud the workflow is read from the workflow scheme of the project. On Jira
+Server only the transitions learned by 'jira move' are known.
+
+Formats: text (default), mermaid, dot.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		project := viper.GetString("jira_project")
+		if len(args) > 0 {
+			project = args[0]
+		}
+		if project == "" {
+			return fmt.Errorf("project is required (or set a default with 'jira project KEY --set-default')")
+		}
+
+		issueType, _ := cmd.Flags().GetString("type")
+		if issueType == "" {
+			issueType = projectDefault(project, "issue_type")
+		}
+		if issueType == "" {
+			return fmt.Errorf("--type is required (or set a default with 'jira project KEY --default-type TYPE')")
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+
+		client := jira.NewClient()
+		if err := client.DetectInstanceType(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not detect Jira instance type: %v\n", err)
+		}
+
+		graph, err := client.GetWorkflow(project, issueType)
+		if err != nil {
+			return fmt.Errorf("failed to get workflow: %w", err)
+		}
+		if graph.Source == "learned" {
+			fmt.Fprintf(os.Stderr, "Note: showing transitions learned from previous moves; the workflow may be incomplete\n")
+		}
+
+		if output.Structured() {
+			return output.Print(graph)
+		}
+
+		switch format {
+		case "mermaid":
+			fmt.Print(renderWorkflowMermaid(graph))
+		case "dot":
+			fmt.Print(renderWorkflowDOT(graph))
+		case "text":
+			return printWorkflow(graph)
+		default:
+			return fmt.Errorf("unsupported format '%s' (use text, mermaid or dot)", format)
+		}
+		return nil
+	},
+}
+
+var categoryOrder = map[string]int{"new": 0, "indeterminate": 1, "done": 2}
+
+var categoryLabels = map[string]string{
+	"new":           "To Do",
+	"indeterminate": "In Progress",
+	"done":          "Done",
+}
+
+var categoryColors = map[string]string{
+	"new":           "#DFE1E6",
+	"indeterminate": "#DEEBFF",
+	"done":          "#E3FCEF",
+}
+
+func workflowStatuses(graph *jira.WorkflowGraph) []string {
+	statuses := graph.Statuses()
+	sort.SliceStable(statuses, func(i, j int) bool {
+		ci, ok := categoryOrder[graph.Categories[statuses[i]]]
+		if !ok {
+			ci = len(categoryOrder)
+		}
+		cj, ok := categoryOrder[graph.Categories[statuses[j]]]
+		if !ok {
+			cj = len(categoryOrder)
+		}
+		return ci < cj
+	})
+	return statuses
+}
+
+func printWorkflow(graph *jira.WorkflowGraph) error {
+	statuses := workflowStatuses(graph)
+	if output.Human() {
+		title := fmt.Sprintf("%s / %s", graph.Project, graph.IssueType)
+		if graph.Name != "" {
+			title += fmt.Sprintf(" (%s)", graph.Name)
+		}
+
+		table := output.NewTable("Status", "Category")
+		table.Title = "Workflow for " + title
+		for _, s := range statuses {
+			table.AddRow(s, valueOrDash(categoryLabels[graph.Categories[s]]))
+		}
+		if err := table.Print(); err != nil {
+			return err
+		}
+		fmt.Println()
+	}
+
+	table := output.NewTable("From", "Transition", "ID", "To")
+	for _, from := range statuses {
+		for _, edge := range graph.Edges[from] {
+			table.AddRow(from, edge.Name, edge.TransitionID, edge.To)
+		}
+	}
+	return table.Print()
+}
+
+func renderWorkflowMermaid(graph *jira.WorkflowGraph) string {
+	var b strings.Builder
+	b.WriteString("stateDiagram-v2\n")
+
+	statuses := workflowStatuses(graph)
+	ids := make(map[string]string, len(statuses))
+	for i, s := range statuses {
+		ids[s] = fmt.Sprintf("s%d", i)
+		fmt.Fprintf(&b, "    state \"%s\" as %s\n", strings.ReplaceAll(s, `"`, "'"), ids[s])
+	}
+	for _, from := range statuses {
+		for _, edge := range graph.Edges[from] {
+			fmt.Fprintf(&b, "    %s --> %s: %s\n", ids[from], ids[edge.To], strings.ReplaceAll(edge.Name, ":", " "))
+		}
+	}
+
+	for _, category := range []string{"new", "indeterminate", "done"} {
+		var members []string
+		for _, s := range statuses {
+			if graph.Categories[s] == category {
+				members = append(members, ids[s])
+			}
+		}
+		if len(members) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "    classDef %s fill:%s\n", category, categoryColors[category])
+		fmt.Fprintf(&b, "    class %s %s\n", strings.Join(members, ","), category)
+	}
+
+	return b.String()
+}
+
+func renderWorkflowDOT(graph *jira.WorkflowGraph) string {
+	quote := func(s string) string {
+		return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+	}
+
+	var b strings.Builder
+	b.WriteString("digraph workflow {\n")
+	b.WriteString("    rankdir=LR;\n")
+	b.WriteString("    node [shape=box, style=\"rounded,filled\", fillcolor=\"#FFFFFF\"];\n")
+
+	statuses := workflowStatuses(graph)
+	for _, s := range statuses {
+		if color, ok := categoryColors[graph.Categories[s]]; ok {
+			fmt.Fprintf(&b, "    %s [fillcolor=%s];\n", quote(s), quote(color))
+		} else {
+			fmt.Fprintf(&b, "    %s;\n", quote(s))
+		}
+	}
+	for _, from := range statuses {
+		for _, edge := range graph.Edges[from] {
+			fmt.Fprintf(&b, "    %s -> %s [label=%s];\n", quote(from), quote(edge.To), quote(edge.Name))
+		}
+	}
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+func init() {
+	Cmd.AddCommand(workflowCmd)
+	workflowCmd.Flags().StringP("type", "t", "", "Issue type (defaults to the project's default issue type)")
+	workflowCmd.Flags().String("format", "text", "Output format: text, mermaid, dot")
+}
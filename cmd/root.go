@@ -6,6 +6,7 @@ import (
 
 	"github.com/joselrodrigues/atlassian/cmd/confluence"
 	"github.com/joselrodrigues/atlassian/cmd/jira"
+	"github.com/joselrodrigues/atlassian/internal/config"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -26,18 +27,33 @@ func Execute() {
 func init() {
 	cobra.OnInitialize(initConfig)
 
-	rootCmd.PersistentFlags().StringP("output", "o", "text", "Output format: text, json")
+	rootCmd.PersistentFlags().StringP("output", "o", "text", "Output format: text, json, jsonl, yaml, csv, tsv, markdown, template")
 	viper.BindPFlag("output", rootCmd.PersistentFlags().Lookup("output"))
+	rootCmd.PersistentFlags().String("template", "", "Go template applied to each result with -o template (e.g. '{{.Key}} {{.Fields.Summary}}')")
+	viper.BindPFlag("template", rootCmd.PersistentFlags().Lookup("template"))
+	rootCmd.PersistentFlags().String("template-file", "", "Read the -o template from a file")
+	viper.BindPFlag("template_file", rootCmd.PersistentFlags().Lookup("template-file"))
+	rootCmd.PersistentFlags().String("jq", "", "Filter JSON output with a jq expression (e.g. '.issues[] | .key'); implies -o json")
+	viper.BindPFlag("jq", rootCmd.PersistentFlags().Lookup("jq"))
+	rootCmd.PersistentFlags().Bool("no-header", false, "Omit the header row from text, markdown, csv and tsv output")
+	viper.BindPFlag("no_header", rootCmd.PersistentFlags().Lookup("no-header"))
+	rootCmd.PersistentFlags().Bool("dry-run", false, "Print the requests mutating commands would send instead of sending them")
+	viper.BindPFlag("dry_run", rootCmd.PersistentFlags().Lookup("dry-run"))
 
 	rootCmd.AddCommand(jira.Cmd)
 	rootCmd.AddCommand(confluence.Cmd)
 }
 
 func initConfig() {
+	if err := config.Load(); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
 	viper.AutomaticEnv()
 
 	viper.BindEnv("jira_token", "JIRA_TOKEN")
 	viper.BindEnv("jira_base_url", "JIRA_BASE_URL")
+	viper.BindEnv("jira_project", "JIRA_PROJECT")
 	viper.BindEnv("confluence_token", "CONFLUENCE_TOKEN")
 	viper.BindEnv("confluence_base_url", "CONFLUENCE_BASE_URL")
 }
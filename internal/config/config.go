@@ -0,0 +1,101 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+	"go.yaml.in/yaml/v3"
+)
+
+func Path() string {
+	if path := os.Getenv("ATLASSIAN_CONFIG"); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "atlassian", "config.yaml")
+}
+
+func Load() error {
+	path := Path()
+	if path == "" {
+		return nil
+	}
+
+	viper.SetConfigFile(path)
+	if err := viper.ReadInConfig(); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to read config %s: %w", path, err)
+	}
+	return nil
+}
+
+func Set(keys []string, value interface{}) error {
+	return update(func(settings map[string]interface{}) {
+		node := settings
+		for _, key := range keys[:len(keys)-1] {
+			child, ok := node[key].(map[string]interface{})
+			if !ok {
+				child = make(map[string]interface{})
+				node[key] = child
+			}
+			node = child
+		}
+		node[keys[len(keys)-1]] = value
+	})
+}
+
+func Unset(keys []string) error {
+	return update(func(settings map[string]interface{}) {
+		node := settings
+		for _, key := range keys[:len(keys)-1] {
+			child, ok := node[key].(map[string]interface{})
+			if !ok {
+				return
+			}
+			node = child
+		}
+		delete(node, keys[len(keys)-1])
+	})
+}
+
+func update(apply func(settings map[string]interface{})) error {
+	path := Path()
+	if path == "" {
+		return fmt.Errorf("could not determine config directory")
+	}
+
+	settings := make(map[string]interface{})
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+	if err := yaml.Unmarshal(data, &settings); err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+	if settings == nil {
+		settings = make(map[string]interface{})
+	}
+
+	apply(settings)
+
+	out, err := yaml.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(path, out, 0o600); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+
+	return Load()
+}
@@ -0,0 +1,131 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+var (
+	queryNamePattern   = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+	placeholderPattern = regexp.MustCompile(`\{([A-Za-z0-9_-]+)\}`)
+)
+
+type Query struct {
+	Name        string `json:"name" yaml:"-"`
+	JQL         string `json:"jql" yaml:"jql"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	FilterID    string `json:"filterId,omitempty" yaml:"filter_id,omitempty"`
+}
+
+func QueryName(name string) (string, error) {
+	name = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(name), "@"))
+	if !queryNamePattern.MatchString(name) {
+		return "", fmt.Errorf("invalid query name '%s' (use letters, digits, '-' and '_')", name)
+	}
+	return name, nil
+}
+
+func Queries() []Query {
+	var queries []Query
+	for name := range viper.GetStringMap("queries") {
+		if q, err := GetQuery(name); err == nil {
+			queries = append(queries, *q)
+		}
+	}
+	sort.Slice(queries, func(i, j int) bool {
+		return queries[i].Name < queries[j].Name
+	})
+	return queries
+}
+
+func GetQuery(name string) (*Query, error) {
+	name, err := QueryName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	key := "queries." + name
+	if !viper.IsSet(key + ".jql") {
+		return nil, fmt.Errorf("query '%s' not found", name)
+	}
+
+	return &Query{
+		Name:        name,
+		JQL:         viper.GetString(key + ".jql"),
+		Description: viper.GetString(key + ".description"),
+		FilterID:    viper.GetString(key + ".filter_id"),
+	}, nil
+}
+
+func SaveQuery(q Query) error {
+	name, err := QueryName(q.Name)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(q.JQL) == "" {
+		return fmt.Errorf("query '%s' has no JQL", name)
+	}
+	return Set([]string{"queries", name}, q)
+}
+
+func DeleteQuery(name string) error {
+	name, err := QueryName(name)
+	if err != nil {
+		return err
+	}
+	if _, err := GetQuery(name); err != nil {
+		return err
+	}
+	return Unset([]string{"queries", name})
+}
+
+func (q Query) Placeholders() []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, m := range placeholderPattern.FindAllStringSubmatch(q.JQL, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			names = append(names, m[1])
+		}
+	}
+	return names
+}
+
+func (q Query) Expand(params map[string]string) (string, error) {
+	var missing []string
+	jql := placeholderPattern.ReplaceAllStringFunc(q.JQL, func(match string) string {
+		name := match[1 : len(match)-1]
+		if value, ok := params[name]; ok {
+			return value
+		}
+		missing = append(missing, name)
+		return match
+	})
+
+	if len(missing) > 0 {
+		return "", fmt.Errorf("query '%s' needs values for: %s (pass them as name=value)", q.Name, strings.Join(missing, ", "))
+	}
+	return jql, nil
+}
+
+func ExpandQuery(ref string, args []string) (string, error) {
+	q, err := GetQuery(ref)
+	if err != nil {
+		return "", err
+	}
+
+	params := make(map[string]string, len(args))
+	for _, arg := range args {
+		name, value, ok := strings.Cut(arg, "=")
+		if !ok || name == "" {
+			return "", fmt.Errorf("invalid parameter '%s', expected name=value", arg)
+		}
+		params[name] = value
+	}
+
+	return q.Expand(params)
+}
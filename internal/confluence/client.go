@@ -8,9 +8,11 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"os"
 	"strings"
 	"time"
 
+	"github.com/joselrodrigues/atlassian/internal/dryrun"
 	"github.com/spf13/viper"
 )
 
@@ -34,9 +36,11 @@ func NewClient() *Client {
 }
 
 func (c *Client) doRequest(method, endpoint string, body interface{}) ([]byte, error) {
+	var jsonBody []byte
 	var bodyReader io.Reader
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal body: %w", err)
 		}
@@ -55,6 +59,11 @@ func (c *Client) doRequest(method, endpoint string, body interface{}) ([]byte, e
 	req.Header.Set("Accept-Encoding", "gzip, deflate")
 	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AtlassianCLI/1.0")
 
+	if dryrun.Intercepts(req) {
+		dryrun.Print(os.Stderr, req, jsonBody)
+		return dryrun.Response(jsonBody), nil
+	}
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
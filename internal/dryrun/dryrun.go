@@ -0,0 +1,118 @@
+package dryrun
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const Placeholder = "DRY-RUN"
+
+var sensitiveKeys = []string{"password", "token", "secret", "apikey", "api_key", "authorization"}
+
+var readOnlyPosts = []string{"/jql/parse"}
+
+func Enabled() bool {
+	return viper.GetBool("dry_run")
+}
+
+func Intercepts(req *http.Request) bool {
+	if !Enabled() || req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return false
+	}
+	if req.Method == http.MethodPost {
+		for _, path := range readOnlyPosts {
+			if strings.HasSuffix(req.URL.Path, path) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func Print(w io.Writer, req *http.Request, body []byte) {
+	fmt.Fprintf(w, "[dry-run] %s %s\n", req.Method, req.URL.String())
+
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := strings.Join(req.Header[name], ", ")
+		if isSensitive(name) {
+			value = "<redacted>"
+		}
+		fmt.Fprintf(w, "[dry-run] %s: %s\n", name, value)
+	}
+
+	if len(body) > 0 {
+		fmt.Fprintf(w, "[dry-run] %s\n", strings.ReplaceAll(redactBody(body), "\n", "\n[dry-run] "))
+	}
+}
+
+func Response(body []byte) []byte {
+	response := make(map[string]interface{})
+	json.Unmarshal(body, &response)
+	for _, key := range []string{"id", "key"} {
+		if _, ok := response[key]; !ok {
+			response[key] = Placeholder
+		}
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		return []byte("{}")
+	}
+	return data
+}
+
+func redactBody(body []byte) string {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(redact(value)); err != nil {
+		return string(body)
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+func redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if isSensitive(key) {
+				v[key] = "<redacted>"
+			} else {
+				v[key] = redact(child)
+			}
+		}
+	case []interface{}:
+		for i, child := range v {
+			v[i] = redact(child)
+		}
+	}
+	return value
+}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,11 +1,8 @@
 package jira
 
 import (
-	"bytes"
-	"compress/gzip"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 )
@@ -50,52 +47,7 @@ type SprintsResponse struct {
 }
 
 func (c *Client) doAgileRequest(method, endpoint string, body interface{}) ([]byte, error) {
-	var bodyReader io.Reader
-	if body != nil {
-		jsonBody, err := json.Marshal(body)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal body: %w", err)
-		}
-		bodyReader = bytes.NewReader(jsonBody)
-	}
-
-	url := fmt.Sprintf("%s/rest/agile/1.0%s", c.baseURL, endpoint)
-	req, err := http.NewRequest(method, url, bodyReader)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Authorization", "Bearer "+c.token)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Accept-Encoding", "gzip, deflate")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	var reader io.Reader = resp.Body
-	if resp.Header.Get("Content-Encoding") == "gzip" {
-		gzReader, err := gzip.NewReader(resp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create gzip reader: %w", err)
-		}
-		defer gzReader.Close()
-		reader = gzReader
-	}
-
-	respBody, err := io.ReadAll(reader)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(respBody))
-	}
-
-	return respBody, nil
+	return c.send(method, fmt.Sprintf("%s/rest/agile/1.0%s", c.baseURL, endpoint), body)
 }
 
 func (c *Client) GetBoards(projectKey string) (*BoardsResponse, error) {
@@ -139,6 +91,50 @@ func (c *Client) GetSprints(boardID int, state string) (*SprintsResponse, error)
 	return &result, nil
 }
 
+func (c *Client) GetAllSprints(boardID int, state string) ([]Sprint, error) {
+	var sprints []Sprint
+	for {
+		params := url.Values{}
+		params.Set("startAt", fmt.Sprintf("%d", len(sprints)))
+		params.Set("maxResults", "50")
+		if state != "" {
+			params.Set("state", state)
+		}
+
+		endpoint := fmt.Sprintf("/board/%d/sprint?%s", boardID, params.Encode())
+		data, err := c.doAgileRequest(http.MethodGet, endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page SprintsResponse
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("failed to parse sprints: %w", err)
+		}
+
+		sprints = append(sprints, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	return sprints, nil
+}
+
+func (c *Client) GetSprint(sprintID int) (*Sprint, error) {
+	data, err := c.doAgileRequest(http.MethodGet, fmt.Sprintf("/sprint/%d", sprintID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var sprint Sprint
+	if err := json.Unmarshal(data, &sprint); err != nil {
+		return nil, fmt.Errorf("failed to parse sprint: %w", err)
+	}
+
+	return &sprint, nil
+}
+
 func (c *Client) MoveToSprint(sprintID int, issueKeys []string) error {
 	body := map[string]interface{}{
 		"issues": issueKeys,
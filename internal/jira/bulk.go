@@ -0,0 +1,71 @@
+package jira
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+type BulkResult struct {
+	Key     string `json:"key"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+type BulkSummary struct {
+	Total      int          `json:"total"`
+	Succeeded  int          `json:"succeeded"`
+	Failed     int          `json:"failed"`
+	FailedKeys []string     `json:"failedKeys"`
+	RetryJQL   string       `json:"retryJql,omitempty"`
+	Results    []BulkResult `json:"results"`
+}
+
+func RunBulk(keys []string, concurrency int, fn func(key string) error) []BulkResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]BulkResult, len(keys))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				result := BulkResult{Key: keys[i], Success: true}
+				if err := fn(keys[i]); err != nil {
+					result.Success = false
+					result.Error = err.Error()
+				}
+				results[i] = result
+			}
+		}()
+	}
+
+	for i := range keys {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+func SummarizeBulk(results []BulkResult) BulkSummary {
+	summary := BulkSummary{Total: len(results), FailedKeys: []string{}, Results: results}
+	for _, r := range results {
+		if r.Success {
+			summary.Succeeded++
+		} else {
+			summary.Failed++
+			summary.FailedKeys = append(summary.FailedKeys, r.Key)
+		}
+	}
+	if summary.Failed > 0 {
+		summary.RetryJQL = fmt.Sprintf("key in (%s)", strings.Join(summary.FailedKeys, ", "))
+	}
+	return summary
+}
@@ -0,0 +1,46 @@
+package jira
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+var cacheInstancePattern = regexp.MustCompile(`[^A-Za-z0-9.-]+`)
+
+func (c *Client) cachePath(name string) string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	instance := cacheInstancePattern.ReplaceAllString(c.baseURL, "_")
+	return filepath.Join(dir, "atlassian", name+"-"+instance+".json")
+}
+
+func (c *Client) readCache(name string, v interface{}) {
+	path := c.cachePath(name)
+	if path == "" {
+		return
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+	json.Unmarshal(data, v)
+}
+
+func (c *Client) writeCache(name string, v interface{}) {
+	path := c.cachePath(name)
+	if path == "" {
+		return
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return
+	}
+	os.WriteFile(path, data, 0o600)
+}
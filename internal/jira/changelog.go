@@ -0,0 +1,189 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+type Changelog struct {
+	StartAt    int                `json:"startAt"`
+	MaxResults int                `json:"maxResults"`
+	Total      int                `json:"total"`
+	Histories  []ChangelogHistory `json:"histories"`
+}
+
+type ChangelogPage struct {
+	StartAt    int                `json:"startAt"`
+	MaxResults int                `json:"maxResults"`
+	Total      int                `json:"total"`
+	IsLast     bool               `json:"isLast"`
+	Values     []ChangelogHistory `json:"values"`
+}
+
+type HistoryEvent struct {
+	Created string `json:"created"`
+	Author  string `json:"author"`
+	Type    string `json:"type"`
+	Field   string `json:"field"`
+	FieldID string `json:"fieldId,omitempty"`
+	From    string `json:"from,omitempty"`
+	To      string `json:"to,omitempty"`
+}
+
+type ChangelogHistory struct {
+	ID      string          `json:"id"`
+	Author  *User           `json:"author,omitempty"`
+	Created string          `json:"created"`
+	Items   []ChangelogItem `json:"items"`
+}
+
+type ChangelogItem struct {
+	Field      string `json:"field"`
+	FieldType  string `json:"fieldtype"`
+	FieldID    string `json:"fieldId,omitempty"`
+	From       string `json:"from"`
+	FromString string `json:"fromString"`
+	To         string `json:"to"`
+	ToString   string `json:"toString"`
+}
+
+func (i ChangelogItem) IsField(field *Field) bool {
+	if i.FieldID != "" {
+		return i.FieldID == field.ID
+	}
+	return i.Field == field.ID || strings.EqualFold(i.Field, field.Name)
+}
+
+func (h ChangelogHistory) CreatedTime() time.Time {
+	t, _ := ParseTime(h.Created)
+	return t
+}
+
+func (c *Changelog) Sorted() []ChangelogHistory {
+	if c == nil {
+		return nil
+	}
+
+	histories := make([]ChangelogHistory, len(c.Histories))
+	copy(histories, c.Histories)
+	sort.SliceStable(histories, func(i, j int) bool {
+		return histories[i].CreatedTime().Before(histories[j].CreatedTime())
+	})
+	return histories
+}
+
+func (c *Client) GetChangelog(issueKey string) ([]ChangelogHistory, error) {
+	if !c.isCloud {
+		data, err := c.Get(fmt.Sprintf("/issue/%s?expand=changelog&fields=created", issueKey))
+		if err != nil {
+			return nil, err
+		}
+
+		var issue Issue
+		if err := json.Unmarshal(data, &issue); err != nil {
+			return nil, fmt.Errorf("failed to parse changelog: %w", err)
+		}
+		return issue.Changelog.Sorted(), nil
+	}
+
+	var histories []ChangelogHistory
+	for {
+		params := url.Values{}
+		params.Set("startAt", fmt.Sprintf("%d", len(histories)))
+		params.Set("maxResults", "100")
+
+		data, err := c.Get(fmt.Sprintf("/issue/%s/changelog?%s", issueKey, params.Encode()))
+		if err != nil {
+			return nil, err
+		}
+
+		var page ChangelogPage
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("failed to parse changelog: %w", err)
+		}
+
+		histories = append(histories, page.Values...)
+		if page.IsLast || len(page.Values) == 0 || len(histories) >= page.Total {
+			break
+		}
+	}
+
+	changelog := &Changelog{Histories: histories}
+	return changelog.Sorted(), nil
+}
+
+func (c *Client) completeChangelogs(issues []Issue) error {
+	for i := range issues {
+		changelog := issues[i].Changelog
+		if changelog == nil || changelog.Total <= len(changelog.Histories) {
+			continue
+		}
+
+		histories, err := c.GetChangelog(issues[i].Key)
+		if err != nil {
+			return fmt.Errorf("failed to get changelog for %s: %w", issues[i].Key, err)
+		}
+		issues[i].Changelog = &Changelog{Total: len(histories), Histories: histories}
+	}
+	return nil
+}
+
+func (c *Client) GetIssueHistory(issueKey string) ([]HistoryEvent, error) {
+	histories, err := c.GetChangelog(issueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := c.GetComments(issueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []HistoryEvent
+	for _, h := range histories {
+		author := ""
+		if h.Author != nil {
+			author = h.Author.DisplayName
+		}
+		for _, item := range h.Items {
+			eventType := "change"
+			switch item.Field {
+			case "status":
+				eventType = "transition"
+			case "assignee":
+				eventType = "assignment"
+			}
+			events = append(events, HistoryEvent{
+				Created: h.Created,
+				Author:  author,
+				Type:    eventType,
+				Field:   item.Field,
+				FieldID: item.FieldID,
+				From:    item.FromString,
+				To:      item.ToString,
+			})
+		}
+	}
+
+	for _, comment := range comments.Comments {
+		events = append(events, HistoryEvent{
+			Created: comment.Created,
+			Author:  comment.Author.DisplayName,
+			Type:    "comment",
+			Field:   "comment",
+			To:      comment.Body,
+		})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		ti, _ := ParseTime(events[i].Created)
+		tj, _ := ParseTime(events[j].Created)
+		return ti.Before(tj)
+	})
+
+	return events, nil
+}
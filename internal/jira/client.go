@@ -4,21 +4,61 @@ import (
 	"bytes"
 	"compress/gzip"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"os"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/joselrodrigues/atlassian/internal/dryrun"
 	"github.com/spf13/viper"
 )
 
+type APIError struct {
+	Status int
+	Body   []byte
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error (status %d): %s", e.Status, string(e.Body))
+}
+
+func IsStatus(err error, statuses ...int) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	for _, status := range statuses {
+		if apiErr.Status == status {
+			return true
+		}
+	}
+	return false
+}
+
 type Client struct {
 	baseURL    string
 	token      string
 	httpClient *http.Client
 	isCloud    bool
+	limiter    *time.Ticker
+
+	mu     sync.Mutex
+	fields []Field
+}
+
+func (c *Client) SetRateLimit(requestsPerSecond float64) {
+	if c.limiter != nil {
+		c.limiter.Stop()
+		c.limiter = nil
+	}
+	if requestsPerSecond > 0 {
+		c.limiter = time.NewTicker(time.Duration(float64(time.Second) / requestsPerSecond))
+	}
 }
 
 func (c *Client) IsCloud() bool {
@@ -55,17 +95,22 @@ func NewClient() *Client {
 }
 
 func (c *Client) doRequest(method, endpoint string, body interface{}) ([]byte, error) {
+	return c.send(method, fmt.Sprintf("%s/rest/api/2%s", c.baseURL, endpoint), body)
+}
+
+func (c *Client) send(method, apiURL string, body interface{}) ([]byte, error) {
+	var jsonBody []byte
 	var bodyReader io.Reader
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal body: %w", err)
 		}
 		bodyReader = bytes.NewReader(jsonBody)
 	}
 
-	url := fmt.Sprintf("%s/rest/api/2%s", c.baseURL, endpoint)
-	req, err := http.NewRequest(method, url, bodyReader)
+	req, err := http.NewRequest(method, apiURL, bodyReader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -75,6 +120,15 @@ func (c *Client) doRequest(method, endpoint string, body interface{}) ([]byte, e
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Accept-Encoding", "gzip, deflate")
 
+	if dryrun.Intercepts(req) {
+		dryrun.Print(os.Stderr, req, jsonBody)
+		return dryrun.Response(jsonBody), nil
+	}
+
+	if c.limiter != nil {
+		<-c.limiter.C
+	}
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
@@ -97,7 +151,7 @@ func (c *Client) doRequest(method, endpoint string, body interface{}) ([]byte, e
 	}
 
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(respBody))
+		return nil, &APIError{Status: resp.StatusCode, Body: respBody}
 	}
 
 	return respBody, nil
@@ -115,13 +169,25 @@ func (c *Client) Put(endpoint string, body interface{}) ([]byte, error) {
 	return c.doRequest(http.MethodPut, endpoint, body)
 }
 
+func (c *Client) Delete(endpoint string) ([]byte, error) {
+	return c.doRequest(http.MethodDelete, endpoint, nil)
+}
+
 func (c *Client) Search(jql string, fields []string, maxResults int) ([]byte, error) {
+	return c.SearchPage(jql, fields, nil, 0, maxResults)
+}
+
+func (c *Client) SearchPage(jql string, fields, expand []string, startAt, maxResults int) ([]byte, error) {
 	params := url.Values{}
 	params.Set("jql", jql)
+	params.Set("startAt", fmt.Sprintf("%d", startAt))
 	params.Set("maxResults", fmt.Sprintf("%d", maxResults))
 	if len(fields) > 0 {
 		params.Set("fields", strings.Join(fields, ","))
 	}
+	if len(expand) > 0 {
+		params.Set("expand", strings.Join(expand, ","))
+	}
 
 	endpoint := "/search?" + params.Encode()
 	return c.Get(endpoint)
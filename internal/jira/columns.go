@@ -0,0 +1,161 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Column struct {
+	Name    string
+	FieldID string
+}
+
+var sprintNamePattern = regexp.MustCompile(`,name=(.*?),\w+=`)
+
+var builtinColumns = map[string]Column{
+	"key":         {Name: "Key", FieldID: "key"},
+	"summary":     {Name: "Summary", FieldID: "summary"},
+	"status":      {Name: "Status", FieldID: "status"},
+	"priority":    {Name: "Priority", FieldID: "priority"},
+	"assignee":    {Name: "Assignee", FieldID: "assignee"},
+	"reporter":    {Name: "Reporter", FieldID: "reporter"},
+	"type":        {Name: "Type", FieldID: "issuetype"},
+	"issuetype":   {Name: "Type", FieldID: "issuetype"},
+	"project":     {Name: "Project", FieldID: "project"},
+	"labels":      {Name: "Labels", FieldID: "labels"},
+	"components":  {Name: "Components", FieldID: "components"},
+	"fixversions": {Name: "Fix Versions", FieldID: "fixVersions"},
+	"resolution":  {Name: "Resolution", FieldID: "resolution"},
+	"created":     {Name: "Created", FieldID: "created"},
+	"updated":     {Name: "Updated", FieldID: "updated"},
+	"resolved":    {Name: "Resolved", FieldID: "resolutiondate"},
+	"duedate":     {Name: "Due", FieldID: "duedate"},
+	"sp":          {Name: "SP", FieldID: "customfield_10106"},
+}
+
+func (c *Client) ResolveColumns(names []string) ([]Column, error) {
+	columns := make([]Column, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if col, ok := builtinColumns[strings.ToLower(name)]; ok {
+			columns = append(columns, col)
+			continue
+		}
+
+		field, err := c.FindField(name)
+		if err != nil {
+			return nil, fmt.Errorf("unknown column '%s': %w", name, err)
+		}
+		columns = append(columns, Column{Name: field.Name, FieldID: field.ID})
+	}
+	return columns, nil
+}
+
+func (c *Client) AllColumns() ([]Column, error) {
+	fields, err := c.GetFields()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(fields, func(i, j int) bool {
+		return strings.ToLower(fields[i].Name) < strings.ToLower(fields[j].Name)
+	})
+
+	counts := make(map[string]int)
+	for _, field := range fields {
+		counts[strings.ToLower(field.Name)]++
+	}
+
+	columns := []Column{builtinColumns["key"], builtinColumns["summary"]}
+	for _, field := range fields {
+		if field.ID == "issuekey" || field.ID == "summary" {
+			continue
+		}
+		name := field.Name
+		if counts[strings.ToLower(name)] > 1 {
+			name = fmt.Sprintf("%s (%s)", name, field.ID)
+		}
+		columns = append(columns, Column{Name: name, FieldID: field.ID})
+	}
+	return columns, nil
+}
+
+func ColumnFields(columns []Column) []string {
+	fields := make([]string, 0, len(columns))
+	for _, col := range columns {
+		if col.FieldID != "key" {
+			fields = append(fields, col.FieldID)
+		}
+	}
+	return fields
+}
+
+func (col Column) Value(issue Issue) string {
+	if col.FieldID == "key" {
+		return issue.Key
+	}
+	return FormatFieldValue(issue.Fields.Raw[col.FieldID])
+}
+
+func FormatFieldValue(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return string(raw)
+	}
+	return formatValue(value)
+}
+
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		if strings.Contains(v, ".sprint.Sprint@") {
+			if m := sprintNamePattern.FindStringSubmatch(v); m != nil {
+				return m[1]
+			}
+		}
+		if len(v) > 10 && strings.Contains(v, "T") {
+			if t, err := ParseTime(v); err == nil {
+				return t.Local().Format("2006-01-02 15:04")
+			}
+		}
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []interface{}:
+		parts := make([]string, 0, len(v))
+		for _, item := range v {
+			if s := formatValue(item); s != "" {
+				parts = append(parts, s)
+			}
+		}
+		return strings.Join(parts, ", ")
+	case map[string]interface{}:
+		for _, key := range []string{"displayName", "name", "value", "key"} {
+			if s, ok := v[key].(string); ok {
+				return s
+			}
+		}
+		if id, ok := v["id"]; ok {
+			return formatValue(id)
+		}
+		if data, err := json.Marshal(v); err == nil {
+			return string(data)
+		}
+	}
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,109 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type Component struct {
+	ID           string            `json:"id,omitempty"`
+	Name         string            `json:"name"`
+	Description  string            `json:"description,omitempty"`
+	Project      string            `json:"project,omitempty"`
+	Lead         *UserSearchResult `json:"lead,omitempty"`
+	AssigneeType string            `json:"assigneeType,omitempty"`
+	Assignee     *UserSearchResult `json:"assignee,omitempty"`
+}
+
+func (c *Client) GetProjectComponents(projectKey string) ([]Component, error) {
+	data, err := c.Get(fmt.Sprintf("/project/%s/components", projectKey))
+	if err != nil {
+		return nil, err
+	}
+
+	var components []Component
+	if err := json.Unmarshal(data, &components); err != nil {
+		return nil, fmt.Errorf("failed to parse components: %w", err)
+	}
+
+	return components, nil
+}
+
+func (c *Client) FindComponent(projectKey, nameOrID string) (*Component, error) {
+	components, err := c.GetProjectComponents(projectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, comp := range components {
+		if comp.ID == nameOrID || strings.EqualFold(comp.Name, nameOrID) {
+			return &components[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("component '%s' not found in project %s", nameOrID, projectKey)
+}
+
+func (c *Client) ComponentFields(name, description, leadIdentifier, assigneeType string) map[string]interface{} {
+	fields := make(map[string]interface{})
+	if name != "" {
+		fields["name"] = name
+	}
+	if description != "" {
+		fields["description"] = description
+	}
+	if leadIdentifier != "" {
+		if c.isCloud {
+			fields["leadAccountId"] = leadIdentifier
+		} else {
+			fields["leadUserName"] = leadIdentifier
+		}
+	}
+	if assigneeType != "" {
+		fields["assigneeType"] = assigneeType
+	}
+	return fields
+}
+
+func (c *Client) CreateComponent(projectKey string, fields map[string]interface{}) (*Component, error) {
+	fields["project"] = projectKey
+	data, err := c.Post("/component", fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var component Component
+	if err := json.Unmarshal(data, &component); err != nil {
+		return nil, fmt.Errorf("failed to parse component: %w", err)
+	}
+
+	return &component, nil
+}
+
+func (c *Client) UpdateComponent(componentID string, fields map[string]interface{}) (*Component, error) {
+	data, err := c.Put(fmt.Sprintf("/component/%s", componentID), fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var component Component
+	if err := json.Unmarshal(data, &component); err != nil {
+		return nil, fmt.Errorf("failed to parse component: %w", err)
+	}
+
+	return &component, nil
+}
+
+func (c *Client) DeleteComponent(componentID, moveIssuesTo string) error {
+	endpoint := fmt.Sprintf("/component/%s", componentID)
+	if moveIssuesTo != "" {
+		params := url.Values{}
+		params.Set("moveIssuesTo", moveIssuesTo)
+		endpoint += "?" + params.Encode()
+	}
+
+	_, err := c.Delete(endpoint)
+	return err
+}
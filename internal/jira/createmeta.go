@@ -0,0 +1,137 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type CreateMeta struct {
+	IssueType IssueType                  `json:"issueType"`
+	Fields    map[string]TransitionField `json:"fields"`
+}
+
+func (m *CreateMeta) Field(nameOrID string) (*Field, *TransitionField) {
+	if alias, ok := builtinColumns[strings.ToLower(nameOrID)]; ok {
+		if _, ok := m.Fields[alias.FieldID]; ok {
+			nameOrID = alias.FieldID
+		}
+	}
+	for id, meta := range m.Fields {
+		epicLink := strings.EqualFold(nameOrID, "epic") && strings.HasSuffix(meta.Schema.Custom, ":gh-epic-link")
+		if id == nameOrID || strings.EqualFold(meta.Name, nameOrID) || epicLink {
+			meta := meta
+			return &Field{ID: id, Name: meta.Name, Schema: meta.Schema}, &meta
+		}
+	}
+	return nil, nil
+}
+
+func (m *CreateMeta) RequiredFields() []string {
+	return Transition{Fields: m.Fields}.RequiredFields()
+}
+
+func (c *Client) GetCreateMeta(project, issueType string) (*CreateMeta, error) {
+	meta, err := c.getCreateMeta(project, issueType)
+	if IsStatus(err, http.StatusNotFound) {
+		return c.getLegacyCreateMeta(project, issueType)
+	}
+	return meta, err
+}
+
+func (c *Client) getCreateMeta(project, issueType string) (*CreateMeta, error) {
+	data, err := c.Get(fmt.Sprintf("/issue/createmeta/%s/issuetypes?maxResults=200", url.PathEscape(project)))
+	if err != nil {
+		return nil, err
+	}
+
+	var types struct {
+		Values    []IssueType `json:"values"`
+		IssueType []IssueType `json:"issueTypes"`
+	}
+	if err := json.Unmarshal(data, &types); err != nil {
+		return nil, fmt.Errorf("failed to parse issue types: %w", err)
+	}
+
+	issueTypes := append(types.Values, types.IssueType...)
+	var match *IssueType
+	for i, t := range issueTypes {
+		if t.ID == issueType || strings.EqualFold(t.Name, issueType) {
+			match = &issueTypes[i]
+			break
+		}
+	}
+	if match == nil {
+		return nil, unknownIssueType(project, issueType, issueTypes)
+	}
+
+	data, err = c.Get(fmt.Sprintf("/issue/createmeta/%s/issuetypes/%s?maxResults=500", url.PathEscape(project), match.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	var fields struct {
+		Values []struct {
+			FieldID string `json:"fieldId"`
+			TransitionField
+		} `json:"values"`
+		Fields []struct {
+			FieldID string `json:"fieldId"`
+			TransitionField
+		} `json:"fields"`
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("failed to parse create metadata: %w", err)
+	}
+
+	meta := &CreateMeta{IssueType: *match, Fields: make(map[string]TransitionField)}
+	for _, f := range append(fields.Values, fields.Fields...) {
+		meta.Fields[f.FieldID] = f.TransitionField
+	}
+	return meta, nil
+}
+
+func (c *Client) getLegacyCreateMeta(project, issueType string) (*CreateMeta, error) {
+	params := url.Values{}
+	params.Set("projectKeys", project)
+	params.Set("expand", "projects.issuetypes.fields")
+
+	data, err := c.Get("/issue/createmeta?" + params.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Projects []struct {
+			IssueTypes []struct {
+				IssueType
+				Fields map[string]TransitionField `json:"fields"`
+			} `json:"issuetypes"`
+		} `json:"projects"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse create metadata: %w", err)
+	}
+	if len(result.Projects) == 0 {
+		return nil, fmt.Errorf("project '%s' not found or you cannot create issues in it", project)
+	}
+
+	var issueTypes []IssueType
+	for _, t := range result.Projects[0].IssueTypes {
+		if t.ID == issueType || strings.EqualFold(t.Name, issueType) {
+			return &CreateMeta{IssueType: t.IssueType, Fields: t.Fields}, nil
+		}
+		issueTypes = append(issueTypes, t.IssueType)
+	}
+	return nil, unknownIssueType(project, issueType, issueTypes)
+}
+
+func unknownIssueType(project, issueType string, issueTypes []IssueType) error {
+	names := make([]string, len(issueTypes))
+	for i, t := range issueTypes {
+		names[i] = t.Name
+	}
+	return fmt.Errorf("issue type '%s' is not available in %s. Available: %s", issueType, project, strings.Join(names, ", "))
+}
@@ -0,0 +1,26 @@
+package jira
+
+import (
+	"fmt"
+	"time"
+)
+
+var timeLayouts = []string{
+	"2006-01-02T15:04:05.000-0700",
+	"2006-01-02T15:04:05.000Z07:00",
+	time.RFC3339Nano,
+	"2006-01-02",
+}
+
+func ParseTime(value string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized time format: %s", value)
+}
+
+func FormatJQLTime(t time.Time) string {
+	return t.Local().Format("2006-01-02 15:04")
+}
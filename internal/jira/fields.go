@@ -3,6 +3,7 @@ package jira
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 type Field struct {
@@ -16,6 +17,8 @@ type Field struct {
 
 type FieldSchema struct {
 	Type     string `json:"type,omitempty"`
+	Items    string `json:"items,omitempty"`
+	System   string `json:"system,omitempty"`
 	Custom   string `json:"custom,omitempty"`
 	CustomID int    `json:"customId,omitempty"`
 }
@@ -33,3 +36,30 @@ func (c *Client) GetFields() ([]Field, error) {
 
 	return fields, nil
 }
+
+func (c *Client) FindField(nameOrID string) (*Field, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.fields == nil {
+		fields, err := c.GetFields()
+		if err != nil {
+			return nil, err
+		}
+		c.fields = fields
+	}
+	fields := c.fields
+
+	for i, field := range fields {
+		if field.ID == nameOrID {
+			return &fields[i], nil
+		}
+	}
+	for i, field := range fields {
+		if strings.EqualFold(field.Name, nameOrID) {
+			return &fields[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("field '%s' not found", nameOrID)
+}
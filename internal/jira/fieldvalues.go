@@ -0,0 +1,108 @@
+package jira
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+func (c *Client) FieldValue(field *Field, raw string) (interface{}, error) {
+	if field.Schema.Type == "array" {
+		var values []interface{}
+		for _, part := range strings.Split(raw, ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			value, err := c.scalarFieldValue(field, field.Schema.Items, part)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	}
+
+	return c.scalarFieldValue(field, field.Schema.Type, raw)
+}
+
+func (c *Client) scalarFieldValue(field *Field, schemaType, raw string) (interface{}, error) {
+	switch schemaType {
+	case "number":
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("field '%s' expects a number, got '%s'", field.Name, raw)
+		}
+		return value, nil
+	case "priority", "resolution", "issuetype", "version", "component", "status":
+		return map[string]string{"name": raw}, nil
+	case "option":
+		return map[string]string{"value": raw}, nil
+	case "project":
+		return map[string]string{"key": raw}, nil
+	case "user":
+		user, err := NewUserResolver(c).Resolve(raw)
+		if err != nil {
+			return nil, err
+		}
+		if c.isCloud {
+			return map[string]string{"accountId": user.AccountID}, nil
+		}
+		return map[string]string{"name": user.Name}, nil
+	default:
+		return raw, nil
+	}
+}
+
+type FieldChange struct {
+	Field *Field
+	Op    string
+	Value string
+}
+
+func ParseFieldChange(expr string) (name, op, value string, err error) {
+	i := strings.Index(expr, "=")
+	if i > 0 {
+		name, op = expr[:i], "="
+		if last := name[len(name)-1]; last == '+' || last == '-' {
+			name, op = name[:len(name)-1], string(last)+"="
+		}
+		if name = strings.TrimSpace(name); name != "" {
+			return name, op, strings.TrimSpace(expr[i+1:]), nil
+		}
+	}
+	return "", "", "", fmt.Errorf("invalid field change '%s' (use field=value, field+=value or field-=value)", expr)
+}
+
+func (c *Client) ApplyFieldChanges(changes []FieldChange) (map[string]interface{}, map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	update := make(map[string]interface{})
+
+	for _, change := range changes {
+		value, err := c.FieldValue(change.Field, change.Value)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if change.Op == "=" {
+			fields[change.Field.ID] = value
+			continue
+		}
+
+		if change.Field.Schema.Type != "array" {
+			return nil, nil, fmt.Errorf("field '%s' is not a multi-value field, use '='", change.Field.Name)
+		}
+
+		verb := "add"
+		if change.Op == "-=" {
+			verb = "remove"
+		}
+		ops, _ := update[change.Field.ID].([]map[string]interface{})
+		for _, item := range value.([]interface{}) {
+			ops = append(ops, map[string]interface{}{verb: item})
+		}
+		update[change.Field.ID] = ops
+	}
+
+	return fields, update, nil
+}
@@ -0,0 +1,267 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type Filter struct {
+	ID               string            `json:"id,omitempty"`
+	Name             string            `json:"name"`
+	Description      string            `json:"description,omitempty"`
+	JQL              string            `json:"jql"`
+	Owner            *UserSearchResult `json:"owner,omitempty"`
+	Favourite        bool              `json:"favourite,omitempty"`
+	ViewURL          string            `json:"viewUrl,omitempty"`
+	SharePermissions []SharePermission `json:"sharePermissions,omitempty"`
+}
+
+type SharePermission struct {
+	ID      int               `json:"id,omitempty"`
+	Type    string            `json:"type"`
+	Project *Project          `json:"project,omitempty"`
+	Role    *ProjectRole      `json:"role,omitempty"`
+	Group   *Group            `json:"group,omitempty"`
+	User    *UserSearchResult `json:"user,omitempty"`
+}
+
+type ProjectRole struct {
+	ID   int    `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+type Group struct {
+	Name string `json:"name"`
+}
+
+type FiltersPage struct {
+	StartAt    int      `json:"startAt"`
+	MaxResults int      `json:"maxResults"`
+	Total      int      `json:"total"`
+	IsLast     bool     `json:"isLast"`
+	Values     []Filter `json:"values"`
+}
+
+type filterRequest struct {
+	Name             string            `json:"name"`
+	Description      string            `json:"description,omitempty"`
+	JQL              string            `json:"jql"`
+	Favourite        bool              `json:"favourite,omitempty"`
+	SharePermissions []SharePermission `json:"sharePermissions,omitempty"`
+}
+
+func (p SharePermission) String() string {
+	switch {
+	case p.Group != nil:
+		return "group:" + p.Group.Name
+	case p.Project != nil && p.Role != nil:
+		return fmt.Sprintf("project:%s:%s", p.Project.Key, p.Role.Name)
+	case p.Project != nil:
+		return "project:" + p.Project.Key
+	case p.User != nil:
+		return "user:" + p.User.DisplayName
+	}
+	return p.Type
+}
+
+func (c *Client) GetFavouriteFilters() ([]Filter, error) {
+	data, err := c.Get("/filter/favourite")
+	if err != nil {
+		return nil, err
+	}
+
+	var filters []Filter
+	if err := json.Unmarshal(data, &filters); err != nil {
+		return nil, fmt.Errorf("failed to parse filters: %w", err)
+	}
+
+	return filters, nil
+}
+
+func (c *Client) GetOwnedFilters() ([]Filter, error) {
+	me, err := c.GetCurrentUser()
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.isCloud {
+		favourites, err := c.GetFavouriteFilters()
+		if err != nil {
+			return nil, err
+		}
+		var owned []Filter
+		for _, f := range favourites {
+			if f.Owner != nil && f.Owner.Name == me.Name {
+				owned = append(owned, f)
+			}
+		}
+		return owned, nil
+	}
+
+	var filters []Filter
+	for {
+		params := url.Values{}
+		params.Set("accountId", me.AccountID)
+		params.Set("expand", "description,jql,favourite,owner,viewUrl")
+		params.Set("startAt", strconv.Itoa(len(filters)))
+		params.Set("maxResults", "50")
+
+		data, err := c.Get("/filter/search?" + params.Encode())
+		if err != nil {
+			return nil, err
+		}
+
+		var page FiltersPage
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("failed to parse filters: %w", err)
+		}
+
+		filters = append(filters, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	return filters, nil
+}
+
+func (c *Client) GetFilter(id string) (*Filter, error) {
+	data, err := c.Get(fmt.Sprintf("/filter/%s", id))
+	if err != nil {
+		return nil, err
+	}
+
+	var filter Filter
+	if err := json.Unmarshal(data, &filter); err != nil {
+		return nil, fmt.Errorf("failed to parse filter: %w", err)
+	}
+
+	return &filter, nil
+}
+
+func (c *Client) FindFilter(nameOrID string) (*Filter, error) {
+	if _, err := strconv.Atoi(nameOrID); err == nil {
+		return c.GetFilter(nameOrID)
+	}
+
+	favourites, err := c.GetFavouriteFilters()
+	if err != nil {
+		return nil, err
+	}
+	for i, f := range favourites {
+		if strings.EqualFold(f.Name, nameOrID) {
+			return &favourites[i], nil
+		}
+	}
+
+	if c.isCloud {
+		params := url.Values{}
+		params.Set("filterName", nameOrID)
+		params.Set("expand", "description,jql,favourite,owner,viewUrl")
+		data, err := c.Get("/filter/search?" + params.Encode())
+		if err != nil {
+			return nil, err
+		}
+
+		var page FiltersPage
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("failed to parse filters: %w", err)
+		}
+		for i, f := range page.Values {
+			if strings.EqualFold(f.Name, nameOrID) {
+				return &page.Values[i], nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("filter '%s' not found", nameOrID)
+}
+
+func (c *Client) CreateFilter(filter Filter) (*Filter, error) {
+	data, err := c.Post("/filter", newFilterRequest(filter))
+	if err != nil {
+		return nil, err
+	}
+
+	var created Filter
+	if err := json.Unmarshal(data, &created); err != nil {
+		return nil, fmt.Errorf("failed to parse filter: %w", err)
+	}
+
+	return &created, nil
+}
+
+func (c *Client) UpdateFilter(id string, filter Filter) (*Filter, error) {
+	data, err := c.Put(fmt.Sprintf("/filter/%s", id), newFilterRequest(filter))
+	if err != nil {
+		return nil, err
+	}
+
+	var updated Filter
+	if err := json.Unmarshal(data, &updated); err != nil {
+		return nil, fmt.Errorf("failed to parse filter: %w", err)
+	}
+
+	return &updated, nil
+}
+
+func (c *Client) DeleteFilter(id string) error {
+	_, err := c.Delete(fmt.Sprintf("/filter/%s", id))
+	return err
+}
+
+func (c *Client) ParseSharePermission(spec string) (*SharePermission, error) {
+	kind, value, _ := strings.Cut(spec, ":")
+	switch strings.ToLower(kind) {
+	case "global":
+		return &SharePermission{Type: "global"}, nil
+	case "authenticated", "loggedin":
+		if c.isCloud {
+			return &SharePermission{Type: "authenticated"}, nil
+		}
+		return &SharePermission{Type: "loggedin"}, nil
+	case "group":
+		if value == "" {
+			return nil, fmt.Errorf("group name is required in '%s'", spec)
+		}
+		return &SharePermission{Type: "group", Group: &Group{Name: value}}, nil
+	case "project":
+		if value == "" {
+			return nil, fmt.Errorf("project key is required in '%s'", spec)
+		}
+		project, err := c.GetProject(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project %s: %w", value, err)
+		}
+		return &SharePermission{Type: "project", Project: &Project{Key: project.Key, ID: project.ID}}, nil
+	case "user":
+		if value == "" {
+			return nil, fmt.Errorf("user is required in '%s'", spec)
+		}
+		user, err := NewUserResolver(c).Resolve(value)
+		if err != nil {
+			return nil, err
+		}
+		return &SharePermission{Type: "user", User: &UserSearchResult{AccountID: user.AccountID, Name: user.Name}}, nil
+	}
+	return nil, fmt.Errorf("invalid share '%s' (use global, authenticated, group:NAME, project:KEY or user:USER)", spec)
+}
+
+func newFilterRequest(filter Filter) filterRequest {
+	permissions := make([]SharePermission, len(filter.SharePermissions))
+	for i, p := range filter.SharePermissions {
+		p.ID = 0
+		permissions[i] = p
+	}
+
+	return filterRequest{
+		Name:             filter.Name,
+		Description:      filter.Description,
+		JQL:              filter.JQL,
+		Favourite:        filter.Favourite,
+		SharePermissions: permissions,
+	}
+}
@@ -0,0 +1,259 @@
+package jira
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+type FlowReport struct {
+	Issues    []FlowIssue `json:"issues"`
+	Statuses  []string    `json:"statuses"`
+	CycleTime Percentiles `json:"cycleTimeDays"`
+	LeadTime  Percentiles `json:"leadTimeDays"`
+	CFD       []CFDDay    `json:"cumulativeFlow"`
+}
+
+type FlowIssue struct {
+	Key           string             `json:"key"`
+	Summary       string             `json:"summary"`
+	Type          string             `json:"type"`
+	Status        string             `json:"status"`
+	Created       string             `json:"created"`
+	Resolved      string             `json:"resolved,omitempty"`
+	LeadTimeDays  *float64           `json:"leadTimeDays"`
+	CycleTimeDays *float64           `json:"cycleTimeDays"`
+	TimeInStatus  map[string]float64 `json:"timeInStatusDays"`
+}
+
+type Percentiles struct {
+	Count int     `json:"count"`
+	Mean  float64 `json:"mean"`
+	P50   float64 `json:"p50"`
+	P75   float64 `json:"p75"`
+	P85   float64 `json:"p85"`
+	P95   float64 `json:"p95"`
+}
+
+type CFDDay struct {
+	Date     string         `json:"date"`
+	Statuses map[string]int `json:"statuses"`
+}
+
+type statusInterval struct {
+	id      string
+	name    string
+	entered time.Time
+}
+
+func (c *Client) GetFlowReport(jql string, cfdDays int) (*FlowReport, error) {
+	categories, err := c.GetStatusCategories()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status categories: %w", err)
+	}
+
+	fields := []string{"summary", "status", "issuetype", "created", "resolutiondate"}
+	issues, err := c.SearchAllIssues(jql, fields, []string{"changelog"})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.completeChangelogs(issues); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	report := &FlowReport{}
+	var cycleTimes, leadTimes []float64
+	allIntervals := make([][]statusInterval, 0, len(issues))
+	firstSeen := make(map[string]int)
+
+	for _, issue := range issues {
+		intervals := statusIntervals(issue, issue.Changelog.Sorted())
+		allIntervals = append(allIntervals, intervals)
+
+		entry := FlowIssue{
+			Key:          issue.Key,
+			Summary:      issue.Fields.Summary,
+			Type:         issue.Fields.IssueType.Name,
+			Status:       issue.Fields.Status.Name,
+			Created:      issue.Fields.Created,
+			Resolved:     issue.Fields.Resolved,
+			TimeInStatus: make(map[string]float64),
+		}
+
+		active := len(intervals)
+		for active > 0 && categories[intervals[active-1].id] == "done" {
+			active--
+		}
+
+		var cycleStart, doneAt time.Time
+		for i, interval := range intervals {
+			if _, ok := firstSeen[interval.name]; !ok {
+				firstSeen[interval.name] = len(firstSeen)
+			}
+
+			if i < active {
+				left := now
+				if i+1 < len(intervals) {
+					left = intervals[i+1].entered
+				}
+				entry.TimeInStatus[interval.name] += days(left.Sub(interval.entered))
+			}
+
+			switch categories[interval.id] {
+			case "indeterminate":
+				if cycleStart.IsZero() {
+					cycleStart = interval.entered
+				}
+				doneAt = time.Time{}
+			case "done":
+				if doneAt.IsZero() {
+					doneAt = interval.entered
+				}
+			default:
+				doneAt = time.Time{}
+			}
+		}
+
+		if !cycleStart.IsZero() && !doneAt.IsZero() {
+			cycle := days(doneAt.Sub(cycleStart))
+			entry.CycleTimeDays = &cycle
+			cycleTimes = append(cycleTimes, cycle)
+		}
+
+		resolvedAt, err := ParseTime(issue.Fields.Resolved)
+		if err != nil {
+			resolvedAt = doneAt
+		}
+		if created, err := ParseTime(issue.Fields.Created); err == nil && !resolvedAt.IsZero() {
+			lead := days(resolvedAt.Sub(created))
+			entry.LeadTimeDays = &lead
+			leadTimes = append(leadTimes, lead)
+		}
+
+		report.Issues = append(report.Issues, entry)
+	}
+
+	report.CycleTime = computePercentiles(cycleTimes)
+	report.LeadTime = computePercentiles(leadTimes)
+	report.Statuses = orderStatuses(firstSeen, categories, issues)
+	report.CFD = cumulativeFlow(allIntervals, cfdDays, now)
+
+	return report, nil
+}
+
+func statusIntervals(issue Issue, histories []ChangelogHistory) []statusInterval {
+	created, _ := ParseTime(issue.Fields.Created)
+	current := statusInterval{id: issue.Fields.Status.ID, name: issue.Fields.Status.Name, entered: created}
+
+	var intervals []statusInterval
+	for _, h := range histories {
+		for _, item := range h.Items {
+			if item.Field != "status" {
+				continue
+			}
+			if len(intervals) == 0 {
+				intervals = append(intervals, statusInterval{id: item.From, name: item.FromString, entered: created})
+			}
+			intervals = append(intervals, statusInterval{id: item.To, name: item.ToString, entered: h.CreatedTime()})
+		}
+	}
+
+	if len(intervals) == 0 {
+		intervals = append(intervals, current)
+	}
+	return intervals
+}
+
+func orderStatuses(firstSeen map[string]int, categories map[string]string, issues []Issue) []string {
+	rank := map[string]int{"new": 0, "indeterminate": 1, "done": 2}
+	nameCategory := make(map[string]string)
+	for _, issue := range issues {
+		for _, h := range issue.Changelog.Sorted() {
+			for _, item := range h.Items {
+				if item.Field == "status" {
+					nameCategory[item.FromString] = categories[item.From]
+					nameCategory[item.ToString] = categories[item.To]
+				}
+			}
+		}
+		nameCategory[issue.Fields.Status.Name] = categories[issue.Fields.Status.ID]
+	}
+
+	statuses := make([]string, 0, len(firstSeen))
+	for name := range firstSeen {
+		statuses = append(statuses, name)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		ri, rj := rank[nameCategory[statuses[i]]], rank[nameCategory[statuses[j]]]
+		if ri != rj {
+			return ri < rj
+		}
+		return firstSeen[statuses[i]] < firstSeen[statuses[j]]
+	})
+	return statuses
+}
+
+func cumulativeFlow(allIntervals [][]statusInterval, cfdDays int, now time.Time) []CFDDay {
+	if cfdDays <= 0 {
+		return nil
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	result := make([]CFDDay, 0, cfdDays)
+	for d := cfdDays - 1; d >= 0; d-- {
+		day := today.AddDate(0, 0, -d)
+		at := day.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		if at.After(now) {
+			at = now
+		}
+
+		entry := CFDDay{Date: day.Format("2006-01-02"), Statuses: make(map[string]int)}
+		for _, intervals := range allIntervals {
+			var name string
+			for _, interval := range intervals {
+				if interval.entered.After(at) {
+					break
+				}
+				name = interval.name
+			}
+			if name != "" {
+				entry.Statuses[name]++
+			}
+		}
+		result = append(result, entry)
+	}
+	return result
+}
+
+func computePercentiles(values []float64) Percentiles {
+	p := Percentiles{Count: len(values)}
+	if len(values) == 0 {
+		return p
+	}
+
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	var sum float64
+	for _, v := range sorted {
+		sum += v
+	}
+	p.Mean = sum / float64(len(sorted))
+
+	rank := func(pct float64) float64 {
+		idx := int(math.Ceil(pct/100*float64(len(sorted)))) - 1
+		if idx < 0 {
+			idx = 0
+		}
+		return sorted[idx]
+	}
+	p.P50, p.P75, p.P85, p.P95 = rank(50), rank(75), rank(85), rank(95)
+	return p
+}
+
+func days(d time.Duration) float64 {
+	return math.Round(d.Hours()/24*100) / 100
+}
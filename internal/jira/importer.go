@@ -0,0 +1,328 @@
+package jira
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var issueKeyPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*-\d+$`)
+
+type ImportRow struct {
+	Number int
+	Values map[string]string
+}
+
+type PreparedIssue struct {
+	Row     int                    `json:"row"`
+	Summary string                 `json:"summary"`
+	Type    string                 `json:"type"`
+	Fields  map[string]interface{} `json:"fields"`
+	Waiting []string               `json:"waitingFor,omitempty"`
+}
+
+type ImportResult struct {
+	Row   int    `json:"row"`
+	Key   string `json:"key,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+type Importer struct {
+	client      *Client
+	project     string
+	defaultType string
+	mapping     map[string]string
+
+	meta    map[string]*CreateMeta
+	sprints []Sprint
+	found   map[string]string
+	local   map[string][]int
+	keys    map[int]string
+	ignored map[string]bool
+}
+
+func ParseImportMapping(specs []string) (map[string]string, error) {
+	mapping := make(map[string]string)
+	for _, spec := range specs {
+		column, field, ok := strings.Cut(spec, "=")
+		column, field = strings.TrimSpace(column), strings.TrimSpace(field)
+		if !ok || column == "" || field == "" {
+			return nil, fmt.Errorf("invalid mapping '%s', expected Column=field", spec)
+		}
+		mapping[strings.ToLower(column)] = field
+	}
+	return mapping, nil
+}
+
+func NewImporter(client *Client, project, defaultType string, mapping map[string]string) *Importer {
+	return &Importer{
+		client:      client,
+		project:     project,
+		defaultType: defaultType,
+		mapping:     mapping,
+		meta:        make(map[string]*CreateMeta),
+		found:       make(map[string]string),
+		local:       make(map[string][]int),
+		keys:        make(map[int]string),
+		ignored:     make(map[string]bool),
+	}
+}
+
+func (im *Importer) target(column string) string {
+	if field, ok := im.mapping[strings.ToLower(column)]; ok {
+		return field
+	}
+	return column
+}
+
+func (im *Importer) targets(column, fieldID string) bool {
+	target := strings.ToLower(im.target(column))
+	if col, ok := builtinColumns[target]; ok {
+		return col.FieldID == fieldID
+	}
+	return target == fieldID || (fieldID == "issuetype" && target == "issue type")
+}
+
+func (im *Importer) summary(row ImportRow) string {
+	for column, value := range row.Values {
+		if im.targets(column, "summary") {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+func (im *Importer) AddLocal(row ImportRow, key string) {
+	if summary := im.summary(row); summary != "" {
+		name := strings.ToLower(summary)
+		im.local[name] = append(im.local[name], row.Number)
+	}
+	im.keys[row.Number] = key
+}
+
+func (im *Importer) SetKey(row int, key string) {
+	im.keys[row] = key
+}
+
+func (im *Importer) IgnoredColumns() []string {
+	columns := make([]string, 0, len(im.ignored))
+	for column, ignored := range im.ignored {
+		if ignored {
+			columns = append(columns, column)
+		}
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+func (im *Importer) Prepare(row ImportRow) (*PreparedIssue, error) {
+	issueType := im.defaultType
+	for column, value := range row.Values {
+		if im.targets(column, "issuetype") && strings.TrimSpace(value) != "" {
+			issueType = strings.TrimSpace(value)
+		}
+	}
+
+	meta, err := im.createMeta(issueType)
+	if err != nil {
+		return nil, fmt.Errorf("row %d: %w", row.Number, err)
+	}
+
+	issue := &PreparedIssue{
+		Row:  row.Number,
+		Type: meta.IssueType.Name,
+		Fields: map[string]interface{}{
+			"project":   map[string]string{"key": im.project},
+			"issuetype": map[string]string{"id": meta.IssueType.ID},
+		},
+	}
+
+	columns := make([]string, 0, len(row.Values))
+	for column := range row.Values {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	waiting := make(map[string]bool)
+	for _, column := range columns {
+		if im.targets(column, "issuetype") || im.targets(column, "project") {
+			continue
+		}
+
+		raw := strings.TrimSpace(row.Values[column])
+		if raw == "" {
+			continue
+		}
+
+		field, fieldMeta := meta.Field(im.target(column))
+		if field == nil {
+			if _, mapped := im.mapping[strings.ToLower(column)]; mapped {
+				return nil, fmt.Errorf("row %d: field '%s' is not on the create screen of %s in %s", row.Number, im.target(column), meta.IssueType.Name, im.project)
+			}
+			if _, used := im.ignored[column]; !used {
+				im.ignored[column] = true
+			}
+			continue
+		}
+		im.ignored[column] = false
+
+		value, pending, err := im.value(field, fieldMeta, raw)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %s: %w", row.Number, column, err)
+		}
+		if pending {
+			issue.Waiting = append(issue.Waiting, raw)
+			waiting[field.ID] = true
+			continue
+		}
+		issue.Fields[field.ID] = value
+		if field.ID == "summary" {
+			issue.Summary = raw
+		}
+	}
+
+	var missing []string
+	for _, id := range meta.RequiredFields() {
+		if _, ok := issue.Fields[id]; !ok && !waiting[id] {
+			missing = append(missing, fmt.Sprintf("%s (%s)", meta.Fields[id].Name, id))
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("row %d: %s requires: %s", row.Number, meta.IssueType.Name, strings.Join(missing, ", "))
+	}
+
+	return issue, nil
+}
+
+func (im *Importer) value(field *Field, meta *TransitionField, raw string) (interface{}, bool, error) {
+	switch {
+	case field.ID == "parent":
+		key, pending, err := im.issueRef(raw, "")
+		return map[string]string{"key": key}, pending, err
+	case strings.HasSuffix(field.Schema.Custom, ":gh-epic-link"):
+		key, pending, err := im.issueRef(raw, "Epic")
+		return key, pending, err
+	case strings.HasSuffix(field.Schema.Custom, ":gh-sprint"):
+		id, err := im.sprintID(raw)
+		return id, false, err
+	}
+
+	if len(meta.AllowedValues) > 0 {
+		parts := []string{raw}
+		if field.Schema.Type == "array" {
+			parts = strings.Split(raw, ",")
+		}
+		for i, part := range parts {
+			match := findAllowed(meta.AllowedValues, strings.TrimSpace(part))
+			if match == nil {
+				return nil, false, fmt.Errorf("value '%s' is not allowed for %s. Allowed: %s", strings.TrimSpace(part), field.Name, describeAllowed(meta.AllowedValues))
+			}
+			parts[i] = match.Name
+			if parts[i] == "" {
+				parts[i] = match.Value
+			}
+		}
+		raw = strings.Join(parts, ",")
+	}
+
+	value, err := im.client.FieldValue(field, raw)
+	return value, false, err
+}
+
+func (im *Importer) createMeta(issueType string) (*CreateMeta, error) {
+	cacheKey := strings.ToLower(issueType)
+	if meta, ok := im.meta[cacheKey]; ok {
+		return meta, nil
+	}
+
+	meta, err := im.client.GetCreateMeta(im.project, issueType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get create metadata for %s: %w", issueType, err)
+	}
+	im.meta[cacheKey] = meta
+	return meta, nil
+}
+
+func (im *Importer) issueRef(raw, issueType string) (string, bool, error) {
+	if issueKeyPattern.MatchString(raw) {
+		return strings.ToUpper(raw), false, nil
+	}
+
+	name := strings.ToLower(raw)
+	if rows := im.local[name]; len(rows) == 1 {
+		key := im.keys[rows[0]]
+		return key, key == "", nil
+	} else if len(rows) > 1 {
+		numbers := make([]string, len(rows))
+		for i, n := range rows {
+			numbers[i] = strconv.Itoa(n)
+		}
+		return "", false, fmt.Errorf("'%s' matches %d rows of the import (rows %s)", raw, len(rows), strings.Join(numbers, ", "))
+	}
+	if key, ok := im.found[name]; ok {
+		return key, false, nil
+	}
+
+	jql := fmt.Sprintf("project = %s AND summary ~ %s", QuoteJQL(im.project), QuoteJQL(raw))
+	if issueType != "" {
+		jql += " AND issuetype = " + QuoteJQL(issueType)
+	}
+	result, err := im.client.SearchIssuesWithFields(jql, []string{"summary"}, 50)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to look up '%s': %w", raw, err)
+	}
+
+	var keys []string
+	for _, issue := range result.Issues {
+		if strings.EqualFold(issue.Fields.Summary, raw) {
+			keys = append(keys, issue.Key)
+		}
+	}
+	switch len(keys) {
+	case 0:
+		return "", false, fmt.Errorf("no issue named '%s' in %s", raw, im.project)
+	case 1:
+		im.found[name] = keys[0]
+		return keys[0], false, nil
+	}
+	return "", false, fmt.Errorf("'%s' matches %d issues: %s", raw, len(keys), strings.Join(keys, ", "))
+}
+
+func (im *Importer) sprintID(raw string) (int, error) {
+	if id, err := strconv.Atoi(raw); err == nil {
+		return id, nil
+	}
+
+	if im.sprints == nil {
+		boards, err := im.client.GetBoards(im.project)
+		if err != nil {
+			return 0, fmt.Errorf("failed to list boards: %w", err)
+		}
+		seen := make(map[int]bool)
+		im.sprints = []Sprint{}
+		for _, board := range boards.Values {
+			if board.Type != "scrum" {
+				continue
+			}
+			sprints, err := im.client.GetAllSprints(board.ID, "active,future")
+			if err != nil {
+				return 0, fmt.Errorf("failed to list sprints: %w", err)
+			}
+			for _, sprint := range sprints {
+				if !seen[sprint.ID] {
+					seen[sprint.ID] = true
+					im.sprints = append(im.sprints, sprint)
+				}
+			}
+		}
+	}
+
+	for _, sprint := range im.sprints {
+		if strings.EqualFold(sprint.Name, raw) {
+			return sprint.ID, nil
+		}
+	}
+	return 0, fmt.Errorf("no active or future sprint named '%s' on the boards of %s", raw, im.project)
+}
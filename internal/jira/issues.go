@@ -2,12 +2,17 @@ package jira
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"sort"
+	"strings"
 )
 
 type Issue struct {
-	Key    string      `json:"key"`
-	Fields IssueFields `json:"fields"`
+	Key       string      `json:"key"`
+	Fields    IssueFields `json:"fields"`
+	Changelog *Changelog  `json:"changelog,omitempty"`
 }
 
 type IssueFields struct {
@@ -19,11 +24,51 @@ type IssueFields struct {
 	Reporter    *User       `json:"reporter,omitempty"`
 	Project     Project     `json:"project,omitempty"`
 	IssueType   IssueType   `json:"issuetype,omitempty"`
+	Resolution  *Resolution `json:"resolution,omitempty"`
+	Labels      []string    `json:"labels,omitempty"`
+	Created     string      `json:"created,omitempty"`
+	Updated     string      `json:"updated,omitempty"`
+	Resolved    string      `json:"resolutiondate,omitempty"`
 	StoryPoints float64     `json:"customfield_10106,omitempty"`
 	Sprint      interface{} `json:"customfield_10104,omitempty"`
+
+	Raw map[string]json.RawMessage `json:"-"`
+}
+
+func (f *IssueFields) UnmarshalJSON(data []byte) error {
+	type issueFields IssueFields
+	var typed issueFields
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*f = IssueFields(typed)
+	f.Raw = raw
+	return nil
+}
+
+func (f *IssueFields) Number(fieldID string) float64 {
+	var value float64
+	if raw, ok := f.Raw[fieldID]; ok {
+		json.Unmarshal(raw, &value)
+	}
+	return value
 }
 
 type Status struct {
+	ID             string          `json:"id,omitempty"`
+	Name           string          `json:"name"`
+	StatusCategory *StatusCategory `json:"statusCategory,omitempty"`
+}
+
+type StatusCategory struct {
+	ID   int    `json:"id"`
+	Key  string `json:"key"`
 	Name string `json:"name"`
 }
 
@@ -31,23 +76,34 @@ type Priority struct {
 	Name string `json:"name"`
 }
 
+type Resolution struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
 type User struct {
 	DisplayName  string `json:"displayName"`
 	EmailAddress string `json:"emailAddress,omitempty"`
 }
 
 type Project struct {
+	ID   string `json:"id,omitempty"`
 	Key  string `json:"key"`
 	Name string `json:"name,omitempty"`
 }
 
 type IssueType struct {
-	Name string `json:"name"`
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Subtask     bool   `json:"subtask,omitempty"`
 }
 
 type SearchResult struct {
-	Total  int     `json:"total"`
-	Issues []Issue `json:"issues"`
+	StartAt    int     `json:"startAt"`
+	MaxResults int     `json:"maxResults"`
+	Total      int     `json:"total"`
+	Issues     []Issue `json:"issues"`
 }
 
 type CreateIssueRequest struct {
@@ -71,6 +127,11 @@ type UpdateIssueRequest struct {
 	Fields map[string]interface{} `json:"fields"`
 }
 
+type EditIssueRequest struct {
+	Fields map[string]interface{} `json:"fields,omitempty"`
+	Update map[string]interface{} `json:"update,omitempty"`
+}
+
 func (c *Client) GetIssue(issueKey string) (*Issue, error) {
 	data, err := c.Get(fmt.Sprintf("/issue/%s", issueKey))
 	if err != nil {
@@ -108,14 +169,113 @@ func (c *Client) CreateIssue(project, issueType, summary, description string) (*
 	return &resp, nil
 }
 
+type BulkCreateResult struct {
+	Key   string `json:"key,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+func (c *Client) CreateIssues(issues []map[string]interface{}) ([]BulkCreateResult, error) {
+	updates := make([]map[string]interface{}, len(issues))
+	for i, fields := range issues {
+		updates[i] = map[string]interface{}{"fields": fields}
+	}
+
+	data, err := c.Post("/issue/bulk", map[string]interface{}{"issueUpdates": updates})
+	var apiErr *APIError
+	switch {
+	case IsStatus(err, http.StatusNotFound, http.StatusMethodNotAllowed):
+		return c.createIssuesOneByOne(issues), nil
+	case errors.As(err, &apiErr) && apiErr.Status == http.StatusBadRequest:
+		data = apiErr.Body
+	case err != nil:
+		return nil, err
+	}
+
+	var resp struct {
+		Issues []CreateIssueResponse `json:"issues"`
+		Errors []struct {
+			Status        int `json:"status"`
+			ElementErrors struct {
+				ErrorMessages []string          `json:"errorMessages"`
+				Errors        map[string]string `json:"errors"`
+			} `json:"elementErrors"`
+			FailedElementNumber int `json:"failedElementNumber"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	results := make([]BulkCreateResult, len(issues))
+	failed := make(map[int]bool)
+	for _, e := range resp.Errors {
+		if e.FailedElementNumber < 0 || e.FailedElementNumber >= len(issues) {
+			continue
+		}
+		messages := append([]string{}, e.ElementErrors.ErrorMessages...)
+		for field, msg := range e.ElementErrors.Errors {
+			messages = append(messages, field+": "+msg)
+		}
+		sort.Strings(messages)
+		results[e.FailedElementNumber].Error = strings.Join(messages, "; ")
+		failed[e.FailedElementNumber] = true
+	}
+
+	created := resp.Issues
+	for i := range results {
+		if failed[i] {
+			continue
+		}
+		if len(created) == 0 {
+			results[i].Error = "not created"
+			continue
+		}
+		results[i].Key = created[0].Key
+		created = created[1:]
+	}
+	return results, nil
+}
+
+func (c *Client) createIssuesOneByOne(issues []map[string]interface{}) []BulkCreateResult {
+	results := make([]BulkCreateResult, len(issues))
+	for i, fields := range issues {
+		data, err := c.Post("/issue", map[string]interface{}{"fields": fields})
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		var resp CreateIssueResponse
+		if err := json.Unmarshal(data, &resp); err != nil {
+			results[i].Error = fmt.Sprintf("failed to parse response: %v", err)
+			continue
+		}
+		results[i].Key = resp.Key
+	}
+	return results
+}
+
 func (c *Client) UpdateIssue(issueKey string, fields map[string]interface{}) error {
 	req := UpdateIssueRequest{Fields: fields}
 	_, err := c.Put(fmt.Sprintf("/issue/%s", issueKey), req)
 	return err
 }
 
+func (c *Client) EditIssue(issueKey string, fields, update map[string]interface{}) error {
+	req := EditIssueRequest{Fields: fields, Update: update}
+	_, err := c.Put(fmt.Sprintf("/issue/%s", issueKey), req)
+	return err
+}
+
+var defaultSearchFields = []string{"key", "summary", "status", "priority", "assignee", "project", "issuetype", "customfield_10106"}
+
 func (c *Client) SearchIssues(jql string, maxResults int) (*SearchResult, error) {
-	fields := []string{"key", "summary", "status", "priority", "assignee", "customfield_10106"}
+	return c.SearchIssuesWithFields(jql, nil, maxResults)
+}
+
+func (c *Client) SearchIssuesWithFields(jql string, fields []string, maxResults int) (*SearchResult, error) {
+	if len(fields) == 0 {
+		fields = defaultSearchFields
+	}
 	data, err := c.Search(jql, fields, maxResults)
 	if err != nil {
 		return nil, err
@@ -129,9 +289,100 @@ func (c *Client) SearchIssues(jql string, maxResults int) (*SearchResult, error)
 	return &result, nil
 }
 
-func (c *Client) GetMyIssues() (*SearchResult, error) {
-	jql := "assignee = currentUser() AND status NOT IN (Done, Closed, Listo, CERRADO)"
-	return c.SearchIssues(jql, 50)
+func (c *Client) SearchAllIssues(jql string, fields, expand []string) ([]Issue, error) {
+	var issues []Issue
+	err := c.EachSearchPage(jql, fields, expand, func(page *SearchResult) error {
+		issues = append(issues, page.Issues...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return issues, nil
+}
+
+func (c *Client) EachSearchPage(jql string, fields, expand []string, fn func(page *SearchResult) error) error {
+	startAt := 0
+	for {
+		data, err := c.SearchPage(jql, fields, expand, startAt, 100)
+		if err != nil {
+			return err
+		}
+
+		var page SearchResult
+		if err := json.Unmarshal(data, &page); err != nil {
+			return fmt.Errorf("failed to parse search results: %w", err)
+		}
+
+		if err := fn(&page); err != nil {
+			return err
+		}
+
+		startAt += len(page.Issues)
+		if len(page.Issues) == 0 || startAt >= page.Total {
+			return nil
+		}
+	}
+}
+
+type MyIssuesOptions struct {
+	Statuses     []string
+	Projects     []string
+	Types        []string
+	UpdatedSince string
+	OrderBy      string
+	Ascending    bool
+	MaxResults   int
+	Fields       []string
+}
+
+func (o MyIssuesOptions) JQL() (string, error) {
+	clauses := []string{"assignee = currentUser()"}
+
+	if len(o.Statuses) > 0 {
+		clauses = append(clauses, "status in "+JQLList(o.Statuses))
+	} else {
+		clauses = append(clauses, "statusCategory != Done")
+	}
+	if len(o.Projects) > 0 {
+		clauses = append(clauses, "project in "+JQLList(o.Projects))
+	}
+	if len(o.Types) > 0 {
+		clauses = append(clauses, "issuetype in "+JQLList(o.Types))
+	}
+	if o.UpdatedSince != "" {
+		since, err := JQLTime(o.UpdatedSince)
+		if err != nil {
+			return "", err
+		}
+		clauses = append(clauses, "updated >= "+since)
+	}
+
+	jql := strings.Join(clauses, " AND ")
+
+	orderBy := o.OrderBy
+	if orderBy == "" {
+		orderBy = "updated"
+	}
+	direction := "DESC"
+	if o.Ascending {
+		direction = "ASC"
+	}
+	return fmt.Sprintf("%s ORDER BY %s %s", jql, orderBy, direction), nil
+}
+
+func (c *Client) GetMyIssues(opts MyIssuesOptions) (*SearchResult, error) {
+	jql, err := opts.JQL()
+	if err != nil {
+		return nil, err
+	}
+
+	maxResults := opts.MaxResults
+	if maxResults <= 0 {
+		maxResults = 50
+	}
+	return c.SearchIssuesWithFields(jql, opts.Fields, maxResults)
 }
 
 func (c *Client) GetSprintIssues(project string) (*SearchResult, error) {
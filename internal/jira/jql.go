@@ -0,0 +1,193 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var relativeTimePattern = regexp.MustCompile(`^-?\d+[wdhm]$`)
+
+func QuoteJQL(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	return `"` + value + `"`
+}
+
+func JQLList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = QuoteJQL(v)
+	}
+	return "(" + strings.Join(quoted, ", ") + ")"
+}
+
+func JQLTime(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if relativeTimePattern.MatchString(value) {
+		if !strings.HasPrefix(value, "-") {
+			value = "-" + value
+		}
+		return value, nil
+	}
+	if _, err := time.Parse("2006-01-02", value); err == nil {
+		return QuoteJQL(value), nil
+	}
+
+	t, err := ParseTime(value)
+	if err != nil {
+		return "", fmt.Errorf("invalid date '%s' (use YYYY-MM-DD or a relative period like 7d)", value)
+	}
+	return QuoteJQL(FormatJQLTime(t)), nil
+}
+
+type SearchCriteria struct {
+	Projects  []string
+	Statuses  []string
+	Assignees []string
+	Labels    []string
+	Sprint    string
+	Updated   string
+	Text      string
+}
+
+func (s SearchCriteria) Clauses() ([]string, error) {
+	var clauses []string
+
+	if len(s.Projects) > 0 {
+		clauses = append(clauses, "project in "+JQLList(s.Projects))
+	}
+	if len(s.Statuses) > 0 {
+		clauses = append(clauses, "status in "+JQLList(s.Statuses))
+	}
+	if len(s.Assignees) > 0 {
+		var users []string
+		var parts []string
+		for _, a := range s.Assignees {
+			switch strings.ToLower(a) {
+			case "me", "currentuser()":
+				parts = append(parts, "assignee = currentUser()")
+			case "none", "unassigned":
+				parts = append(parts, "assignee is EMPTY")
+			default:
+				users = append(users, a)
+			}
+		}
+		if len(users) > 0 {
+			parts = append(parts, "assignee in "+JQLList(users))
+		}
+		if len(parts) == 1 {
+			clauses = append(clauses, parts[0])
+		} else {
+			clauses = append(clauses, "("+strings.Join(parts, " OR ")+")")
+		}
+	}
+	if len(s.Labels) > 0 {
+		clauses = append(clauses, "labels in "+JQLList(s.Labels))
+	}
+	if s.Sprint != "" {
+		switch strings.ToLower(s.Sprint) {
+		case "current", "open", "active":
+			clauses = append(clauses, "sprint in openSprints()")
+		case "future", "next":
+			clauses = append(clauses, "sprint in futureSprints()")
+		case "closed":
+			clauses = append(clauses, "sprint in closedSprints()")
+		case "none":
+			clauses = append(clauses, "sprint is EMPTY")
+		default:
+			if _, err := strconv.Atoi(s.Sprint); err == nil {
+				clauses = append(clauses, "sprint = "+s.Sprint)
+			} else {
+				clauses = append(clauses, "sprint = "+QuoteJQL(s.Sprint))
+			}
+		}
+	}
+	if s.Updated != "" {
+		since, err := JQLTime(s.Updated)
+		if err != nil {
+			return nil, err
+		}
+		clauses = append(clauses, "updated >= "+since)
+	}
+	if s.Text != "" {
+		clauses = append(clauses, "text ~ "+QuoteJQL(s.Text))
+	}
+
+	return clauses, nil
+}
+
+func (s SearchCriteria) Apply(jql string) (string, error) {
+	clauses, err := s.Clauses()
+	if err != nil {
+		return "", err
+	}
+	if len(clauses) == 0 {
+		return jql, nil
+	}
+
+	query, orderBy := SplitOrderBy(jql)
+	if strings.TrimSpace(query) != "" {
+		clauses = append([]string{"(" + strings.TrimSpace(query) + ")"}, clauses...)
+	}
+
+	result := strings.Join(clauses, " AND ")
+	if orderBy != "" {
+		result += " " + orderBy
+	}
+	return result, nil
+}
+
+func SplitOrderBy(jql string) (string, string) {
+	tokens, err := tokenizeJQL(jql)
+	if err != nil {
+		return jql, ""
+	}
+	for i := 0; i+1 < len(tokens); i++ {
+		if tokens[i].is("ORDER") && tokens[i+1].is("BY") {
+			return strings.TrimSpace(jql[:tokens[i].pos]), strings.TrimSpace(jql[tokens[i].pos:])
+		}
+	}
+	return jql, ""
+}
+
+type jqlParseRequest struct {
+	Queries []string `json:"queries"`
+}
+
+type jqlParseResponse struct {
+	Queries []struct {
+		Query  string   `json:"query"`
+		Errors []string `json:"errors"`
+	} `json:"queries"`
+}
+
+func (c *Client) ValidateJQL(jql string) ([]string, error) {
+	if !c.isCloud {
+		if err := ParseJQL(jql); err != nil {
+			return []string{err.Error()}, nil
+		}
+		return nil, nil
+	}
+
+	data, err := c.Post("/jql/parse?validation=strict", jqlParseRequest{Queries: []string{jql}})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp jqlParseResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse validation result: %w", err)
+	}
+	if len(resp.Queries) == 0 {
+		if err := ParseJQL(jql); err != nil {
+			return []string{err.Error()}, nil
+		}
+		return nil, nil
+	}
+
+	return resp.Queries[0].Errors, nil
+}
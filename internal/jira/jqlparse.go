@@ -0,0 +1,397 @@
+package jira
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type JQLSyntaxError struct {
+	Offset  int
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *JQLSyntaxError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+type jqlTokenKind int
+
+const (
+	tokenWord jqlTokenKind = iota
+	tokenString
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenComma
+	tokenEOF
+)
+
+type jqlToken struct {
+	kind jqlTokenKind
+	text string
+	pos  int
+}
+
+func (t jqlToken) is(keyword string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.text, keyword)
+}
+
+func (t jqlToken) describe() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of query"
+	case tokenString:
+		return "string " + t.text
+	}
+	return "'" + t.text + "'"
+}
+
+var jqlKeywords = []string{
+	"AND", "OR", "NOT", "IN", "IS", "WAS", "CHANGED", "EMPTY", "NULL", "ORDER", "BY",
+	"ASC", "DESC", "AFTER", "BEFORE", "ON", "DURING", "FROM", "TO",
+}
+
+var jqlPredicates = []string{"AFTER", "BEFORE", "ON", "DURING", "BY", "FROM", "TO"}
+
+func isJQLKeyword(t jqlToken, keywords []string) bool {
+	for _, k := range keywords {
+		if t.is(k) {
+			return true
+		}
+	}
+	return false
+}
+
+func newJQLSyntaxError(jql string, offset int, format string, args ...interface{}) *JQLSyntaxError {
+	line, column := 1, 1
+	for _, r := range jql[:offset] {
+		if r == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+	return &JQLSyntaxError{Offset: offset, Line: line, Column: column, Message: fmt.Sprintf(format, args...)}
+}
+
+func tokenizeJQL(jql string) ([]jqlToken, error) {
+	var tokens []jqlToken
+	i := 0
+	for i < len(jql) {
+		r, size := utf8.DecodeRuneInString(jql[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case r == '(':
+			tokens = append(tokens, jqlToken{kind: tokenLParen, text: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, jqlToken{kind: tokenRParen, text: ")", pos: i})
+			i++
+		case r == ',':
+			tokens = append(tokens, jqlToken{kind: tokenComma, text: ",", pos: i})
+			i++
+		case r == '"' || r == '\'':
+			start := i
+			i++
+			closed := false
+			for i < len(jql) {
+				if jql[i] == '\\' {
+					i += 2
+					continue
+				}
+				if rune(jql[i]) == r {
+					closed = true
+					i++
+					break
+				}
+				i++
+			}
+			if !closed {
+				return nil, newJQLSyntaxError(jql, start, "unterminated string")
+			}
+			tokens = append(tokens, jqlToken{kind: tokenString, text: jql[start:i], pos: start})
+		case strings.ContainsRune("=!~<>&|", r):
+			start := i
+			op := string(r)
+			if i+1 < len(jql) {
+				if two := jql[i : i+2]; two == "!=" || two == "!~" || two == ">=" || two == "<=" || two == "&&" || two == "||" {
+					op = two
+				}
+			}
+			i += len(op)
+			tokens = append(tokens, jqlToken{kind: tokenOperator, text: op, pos: start})
+		default:
+			start := i
+			for i < len(jql) {
+				r, size := utf8.DecodeRuneInString(jql[i:])
+				if unicode.IsSpace(r) || strings.ContainsRune(`(),"'=!~<>&|`, r) {
+					break
+				}
+				i += size
+			}
+			tokens = append(tokens, jqlToken{kind: tokenWord, text: jql[start:i], pos: start})
+		}
+	}
+	tokens = append(tokens, jqlToken{kind: tokenEOF, pos: len(jql)})
+	return tokens, nil
+}
+
+type jqlParser struct {
+	jql    string
+	tokens []jqlToken
+	pos    int
+}
+
+func ParseJQL(jql string) error {
+	tokens, err := tokenizeJQL(jql)
+	if err != nil {
+		return err
+	}
+
+	p := &jqlParser{jql: jql, tokens: tokens}
+	if !p.peek().is("ORDER") {
+		if err := p.parseOr(); err != nil {
+			return err
+		}
+	}
+	if p.peek().is("ORDER") {
+		if err := p.parseOrderBy(); err != nil {
+			return err
+		}
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		if t.kind == tokenRParen {
+			return p.errorAt(t, "unbalanced ')'")
+		}
+		return p.errorAt(t, "expected AND, OR or ORDER BY but found %s", t.describe())
+	}
+	return nil
+}
+
+func (p *jqlParser) peek() jqlToken {
+	return p.tokens[p.pos]
+}
+
+func (p *jqlParser) next() jqlToken {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *jqlParser) errorAt(t jqlToken, format string, args ...interface{}) error {
+	return newJQLSyntaxError(p.jql, t.pos, format, args...)
+}
+
+func (p *jqlParser) parseOr() error {
+	if err := p.parseAnd(); err != nil {
+		return err
+	}
+	for p.peek().is("OR") || p.peek().text == "||" || (p.peek().kind == tokenOperator && p.peek().text == "|") {
+		p.next()
+		if err := p.parseAnd(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *jqlParser) parseAnd() error {
+	if err := p.parseNot(); err != nil {
+		return err
+	}
+	for p.peek().is("AND") || p.peek().text == "&&" || (p.peek().kind == tokenOperator && p.peek().text == "&") {
+		p.next()
+		if err := p.parseNot(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *jqlParser) parseNot() error {
+	t := p.peek()
+	if t.is("NOT") || (t.kind == tokenOperator && t.text == "!") {
+		p.next()
+		return p.parseNot()
+	}
+	if t.kind == tokenLParen {
+		p.next()
+		if err := p.parseOr(); err != nil {
+			return err
+		}
+		if p.peek().kind != tokenRParen {
+			return p.errorAt(p.peek(), "expected ')' to close '(' at column %d but found %s", newJQLSyntaxError(p.jql, t.pos, "").Column, p.peek().describe())
+		}
+		p.next()
+		return nil
+	}
+	return p.parseClause()
+}
+
+func (p *jqlParser) parseField() (jqlToken, error) {
+	t := p.next()
+	if t.kind == tokenString || (t.kind == tokenWord && !isJQLKeyword(t, jqlKeywords)) {
+		return t, nil
+	}
+	return t, p.errorAt(t, "expected a field name but found %s", t.describe())
+}
+
+func (p *jqlParser) parseClause() error {
+	field, err := p.parseField()
+	if err != nil {
+		return err
+	}
+
+	t := p.next()
+	switch {
+	case t.kind == tokenOperator && (t.text == "=" || t.text == "!=" || t.text == "~" || t.text == "!~" ||
+		t.text == ">" || t.text == ">=" || t.text == "<" || t.text == "<="):
+		return p.parseOperand(t, false)
+	case t.is("IN"):
+		return p.parseOperand(t, true)
+	case t.is("NOT"):
+		in := p.next()
+		if !in.is("IN") {
+			return p.errorAt(in, "expected IN after NOT but found %s", in.describe())
+		}
+		return p.parseOperand(in, true)
+	case t.is("IS"):
+		if p.peek().is("NOT") {
+			p.next()
+		}
+		value := p.next()
+		if !value.is("EMPTY") && !value.is("NULL") {
+			return p.errorAt(value, "expected EMPTY or NULL after IS but found %s", value.describe())
+		}
+		return nil
+	case t.is("WAS"):
+		if p.peek().is("NOT") {
+			p.next()
+		}
+		list := false
+		if p.peek().is("IN") {
+			p.next()
+			list = true
+		}
+		if err := p.parseOperand(t, list); err != nil {
+			return err
+		}
+		return p.parsePredicates()
+	case t.is("CHANGED"):
+		return p.parsePredicates()
+	}
+	return p.errorAt(t, "expected an operator after %s but found %s", field.describe(), t.describe())
+}
+
+func (p *jqlParser) parseOperand(operator jqlToken, list bool) error {
+	t := p.peek()
+	if t.kind == tokenLParen {
+		if !list {
+			return p.errorAt(t, "a list of values needs IN or NOT IN instead of '%s'", strings.ToUpper(operator.text))
+		}
+		p.next()
+		for {
+			if err := p.parseValue(); err != nil {
+				return err
+			}
+			sep := p.next()
+			if sep.kind == tokenRParen {
+				return nil
+			}
+			if sep.kind != tokenComma {
+				return p.errorAt(sep, "expected ',' or ')' in list but found %s", sep.describe())
+			}
+		}
+	}
+
+	if t.kind == tokenWord && p.tokens[p.pos+1].kind == tokenLParen {
+		return p.parseValue()
+	}
+	if list {
+		return p.errorAt(t, "expected a list of values in parentheses after %s but found %s", strings.ToUpper(operator.text), t.describe())
+	}
+	return p.parseValue()
+}
+
+func (p *jqlParser) parseValue() error {
+	t := p.next()
+	switch {
+	case t.kind == tokenString:
+		return nil
+	case t.is("EMPTY") || t.is("NULL"):
+		return nil
+	case t.kind == tokenWord && !isJQLKeyword(t, jqlKeywords):
+		if p.peek().kind == tokenLParen {
+			return p.parseFunctionArgs()
+		}
+		return nil
+	}
+	return p.errorAt(t, "expected a value but found %s", t.describe())
+}
+
+func (p *jqlParser) parseFunctionArgs() error {
+	open := p.next()
+	if p.peek().kind == tokenRParen {
+		p.next()
+		return nil
+	}
+	for {
+		arg := p.next()
+		if arg.kind != tokenString && arg.kind != tokenWord {
+			return p.errorAt(arg, "expected a function argument but found %s", arg.describe())
+		}
+		sep := p.next()
+		if sep.kind == tokenRParen {
+			return nil
+		}
+		if sep.kind != tokenComma {
+			if sep.kind == tokenEOF {
+				return p.errorAt(open, "unclosed '(' in function call")
+			}
+			return p.errorAt(sep, "expected ',' or ')' in function call but found %s", sep.describe())
+		}
+	}
+}
+
+func (p *jqlParser) parsePredicates() error {
+	for isJQLKeyword(p.peek(), jqlPredicates) {
+		predicate := p.next()
+		if predicate.is("DURING") {
+			if err := p.parseOperand(predicate, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := p.parseValue(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *jqlParser) parseOrderBy() error {
+	p.next()
+	if by := p.next(); !by.is("BY") {
+		return p.errorAt(by, "expected BY after ORDER but found %s", by.describe())
+	}
+	for {
+		if _, err := p.parseField(); err != nil {
+			return err
+		}
+		if p.peek().is("ASC") || p.peek().is("DESC") {
+			p.next()
+		}
+		if p.peek().kind != tokenComma {
+			return nil
+		}
+		p.next()
+	}
+}
@@ -0,0 +1,53 @@
+package jira
+
+import (
+	"fmt"
+	"sort"
+)
+
+type LabelUsage struct {
+	Label  string `json:"label"`
+	Issues int    `json:"issues"`
+}
+
+func (c *Client) GetLabelUsage(jql string) ([]LabelUsage, error) {
+	issues, err := c.SearchAllIssues(jql, []string{"labels"}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int)
+	for _, issue := range issues {
+		for _, label := range issue.Fields.Labels {
+			counts[label]++
+		}
+	}
+
+	usage := make([]LabelUsage, 0, len(counts))
+	for label, count := range counts {
+		usage = append(usage, LabelUsage{Label: label, Issues: count})
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].Issues != usage[j].Issues {
+			return usage[i].Issues > usage[j].Issues
+		}
+		return usage[i].Label < usage[j].Label
+	})
+
+	return usage, nil
+}
+
+func (c *Client) ModifyLabels(issueKey string, add, remove []string) error {
+	var ops []map[string]string
+	for _, label := range remove {
+		ops = append(ops, map[string]string{"remove": label})
+	}
+	for _, label := range add {
+		ops = append(ops, map[string]string{"add": label})
+	}
+	if len(ops) == 0 {
+		return fmt.Errorf("no labels to add or remove")
+	}
+
+	return c.EditIssue(issueKey, nil, map[string]interface{}{"labels": ops})
+}
@@ -0,0 +1,82 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+type ProjectDetails struct {
+	ID             string            `json:"id"`
+	Key            string            `json:"key"`
+	Name           string            `json:"name"`
+	Description    string            `json:"description,omitempty"`
+	ProjectTypeKey string            `json:"projectTypeKey,omitempty"`
+	Lead           *UserSearchResult `json:"lead,omitempty"`
+	IssueTypes     []IssueType       `json:"issueTypes,omitempty"`
+	Components     []Component       `json:"components,omitempty"`
+	Versions       []Version         `json:"versions,omitempty"`
+	Roles          map[string]string `json:"roles,omitempty"`
+}
+
+type ProjectsPage struct {
+	StartAt    int              `json:"startAt"`
+	MaxResults int              `json:"maxResults"`
+	Total      int              `json:"total"`
+	IsLast     bool             `json:"isLast"`
+	Values     []ProjectDetails `json:"values"`
+}
+
+func (c *Client) GetProjects() ([]ProjectDetails, error) {
+	if !c.isCloud {
+		data, err := c.Get("/project?expand=lead,description")
+		if err != nil {
+			return nil, err
+		}
+
+		var projects []ProjectDetails
+		if err := json.Unmarshal(data, &projects); err != nil {
+			return nil, fmt.Errorf("failed to parse projects: %w", err)
+		}
+		return projects, nil
+	}
+
+	var projects []ProjectDetails
+	for {
+		params := url.Values{}
+		params.Set("startAt", fmt.Sprintf("%d", len(projects)))
+		params.Set("maxResults", "50")
+		params.Set("expand", "lead,description")
+
+		data, err := c.Get("/project/search?" + params.Encode())
+		if err != nil {
+			return nil, err
+		}
+
+		var page ProjectsPage
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("failed to parse projects: %w", err)
+		}
+
+		projects = append(projects, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	return projects, nil
+}
+
+func (c *Client) GetProject(projectKey string) (*ProjectDetails, error) {
+	data, err := c.Get(fmt.Sprintf("/project/%s?expand=lead,description,issueTypes", projectKey))
+	if err != nil {
+		return nil, err
+	}
+
+	var project ProjectDetails
+	if err := json.Unmarshal(data, &project); err != nil {
+		return nil, fmt.Errorf("failed to parse project: %w", err)
+	}
+
+	return &project, nil
+}
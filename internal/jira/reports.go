@@ -0,0 +1,379 @@
+package jira
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type ReportOptions struct {
+	PointsField    *Field
+	IncludeRemoved bool
+}
+
+type SprintReport struct {
+	Sprint          Sprint              `json:"sprint"`
+	Completed       []SprintReportIssue `json:"completed"`
+	NotCompleted    []SprintReportIssue `json:"notCompleted"`
+	Removed         []SprintReportIssue `json:"removed"`
+	CommittedPoints float64             `json:"committedPoints"`
+	CompletedPoints float64             `json:"completedPoints"`
+	AddedPoints     float64             `json:"addedPoints"`
+	RemovedPoints   float64             `json:"removedPoints"`
+}
+
+type SprintReportIssue struct {
+	Key     string  `json:"key"`
+	Summary string  `json:"summary"`
+	Type    string  `json:"type"`
+	Status  string  `json:"status"`
+	Points  float64 `json:"points"`
+	Added   bool    `json:"addedMidSprint"`
+}
+
+type Burndown struct {
+	Sprint Sprint        `json:"sprint"`
+	Days   []BurndownDay `json:"days"`
+}
+
+type BurndownDay struct {
+	Date      string   `json:"date"`
+	Ideal     float64  `json:"ideal"`
+	Remaining *float64 `json:"remaining"`
+	Completed *float64 `json:"completed"`
+	Scope     *float64 `json:"scope"`
+}
+
+type VelocityEntry struct {
+	SprintID        int     `json:"sprintId"`
+	SprintName      string  `json:"sprintName"`
+	StartDate       string  `json:"startDate"`
+	EndDate         string  `json:"endDate"`
+	CommittedPoints float64 `json:"committedPoints"`
+	CompletedPoints float64 `json:"completedPoints"`
+}
+
+type sprintIssue struct {
+	issue       Issue
+	histories   []ChangelogHistory
+	currentlyIn bool
+}
+
+func (c *Client) getSprintIssues(sprint *Sprint, start time.Time, opts ReportOptions) ([]sprintIssue, error) {
+	fields := []string{"summary", "status", "issuetype", "project", opts.PointsField.ID}
+	expand := []string{"changelog"}
+
+	inSprint, err := c.SearchAllIssues(fmt.Sprintf("sprint = %d", sprint.ID), fields, expand)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []Issue
+	if opts.IncludeRemoved && len(inSprint) > 0 {
+		jql := fmt.Sprintf("project in (%s) AND updated >= \"%s\" AND (sprint is EMPTY OR sprint != %d)",
+			strings.Join(issueProjects(inSprint), ", "), FormatJQLTime(start), sprint.ID)
+		candidates, err = c.SearchAllIssues(jql, fields, expand)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.completeChangelogs(inSprint); err != nil {
+		return nil, err
+	}
+	if err := c.completeChangelogs(candidates); err != nil {
+		return nil, err
+	}
+
+	issues := make([]sprintIssue, 0, len(inSprint)+len(candidates))
+	for _, issue := range inSprint {
+		issues = append(issues, sprintIssue{issue: issue, histories: issue.Changelog.Sorted(), currentlyIn: true})
+	}
+	for _, issue := range candidates {
+		issues = append(issues, sprintIssue{issue: issue, histories: issue.Changelog.Sorted()})
+	}
+
+	return issues, nil
+}
+
+func (c *Client) GetSprintReport(sprint *Sprint, opts ReportOptions) (*SprintReport, error) {
+	start, end, err := sprintWindow(sprint)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := c.GetStatusCategories()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status categories: %w", err)
+	}
+
+	issues, err := c.getSprintIssues(sprint, start, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &SprintReport{Sprint: *sprint}
+	sprintID := strconv.Itoa(sprint.ID)
+
+	for _, si := range issues {
+		issue, histories := si.issue, si.histories
+		atStart := inSprintAt(histories, sprintID, start, si.currentlyIn)
+		atEnd := inSprintAt(histories, sprintID, end, si.currentlyIn)
+		added := !atStart && addedDuring(histories, sprintID, start, end)
+		if !atStart && !added {
+			continue
+		}
+
+		entry := SprintReportIssue{
+			Key:     issue.Key,
+			Summary: issue.Fields.Summary,
+			Type:    issue.Fields.IssueType.Name,
+			Status:  issue.Fields.Status.Name,
+			Points:  pointsAt(issue, histories, opts.PointsField, end),
+			Added:   added,
+		}
+
+		if atStart {
+			report.CommittedPoints += pointsAt(issue, histories, opts.PointsField, start)
+		} else {
+			report.AddedPoints += entry.Points
+		}
+
+		switch {
+		case !atEnd:
+			report.Removed = append(report.Removed, entry)
+			report.RemovedPoints += entry.Points
+		case categories[statusAt(issue, histories, end)] == "done":
+			report.Completed = append(report.Completed, entry)
+			report.CompletedPoints += entry.Points
+		default:
+			report.NotCompleted = append(report.NotCompleted, entry)
+		}
+	}
+
+	return report, nil
+}
+
+func (c *Client) GetBurndown(sprint *Sprint, opts ReportOptions) (*Burndown, error) {
+	start, end, err := sprintWindow(sprint)
+	if err != nil {
+		return nil, err
+	}
+	if sprint.CompleteDate == "" && sprint.EndDate != "" {
+		if planned, err := ParseTime(sprint.EndDate); err == nil {
+			end = planned
+		}
+	}
+
+	categories, err := c.GetStatusCategories()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status categories: %w", err)
+	}
+
+	issues, err := c.getSprintIssues(sprint, start, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	sprintID := strconv.Itoa(sprint.ID)
+	now := time.Now()
+	burndown := &Burndown{Sprint: *sprint}
+
+	var committed float64
+	for _, si := range issues {
+		if inSprintAt(si.histories, sprintID, start, si.currentlyIn) {
+			committed += pointsAt(si.issue, si.histories, opts.PointsField, start)
+		}
+	}
+
+	days := sprintDays(start, end)
+	for i, day := range days {
+		entry := BurndownDay{Date: day.Format("2006-01-02")}
+		if len(days) > 1 {
+			entry.Ideal = committed * (1 - float64(i)/float64(len(days)-1))
+		}
+
+		at := day.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		if at.After(end) {
+			at = end
+		}
+		if !day.After(now) {
+			if at.After(now) {
+				at = now
+			}
+
+			var scope, completed float64
+			for _, si := range issues {
+				if !inSprintAt(si.histories, sprintID, at, si.currentlyIn) {
+					continue
+				}
+				points := pointsAt(si.issue, si.histories, opts.PointsField, at)
+				scope += points
+				if categories[statusAt(si.issue, si.histories, at)] == "done" {
+					completed += points
+				}
+			}
+
+			remaining := scope - completed
+			entry.Scope = &scope
+			entry.Completed = &completed
+			entry.Remaining = &remaining
+		}
+
+		burndown.Days = append(burndown.Days, entry)
+	}
+
+	return burndown, nil
+}
+
+func (c *Client) GetVelocity(boardID, count int, opts ReportOptions) ([]VelocityEntry, error) {
+	sprints, err := c.GetAllSprints(boardID, "closed")
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(sprints, func(i, j int) bool {
+		return sprintEnd(&sprints[i]).Before(sprintEnd(&sprints[j]))
+	})
+	if count > 0 && len(sprints) > count {
+		sprints = sprints[len(sprints)-count:]
+	}
+
+	entries := make([]VelocityEntry, 0, len(sprints))
+	for i := range sprints {
+		report, err := c.GetSprintReport(&sprints[i], opts)
+		if err != nil {
+			return nil, fmt.Errorf("sprint %d: %w", sprints[i].ID, err)
+		}
+		entries = append(entries, VelocityEntry{
+			SprintID:        sprints[i].ID,
+			SprintName:      sprints[i].Name,
+			StartDate:       sprints[i].StartDate,
+			EndDate:         sprints[i].CompleteDate,
+			CommittedPoints: report.CommittedPoints,
+			CompletedPoints: report.CompletedPoints,
+		})
+	}
+
+	return entries, nil
+}
+
+func sprintWindow(sprint *Sprint) (time.Time, time.Time, error) {
+	if sprint.StartDate == "" {
+		return time.Time{}, time.Time{}, fmt.Errorf("sprint %d has not started", sprint.ID)
+	}
+	start, err := ParseTime(sprint.StartDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start date of sprint %d: %w", sprint.ID, err)
+	}
+	return start, sprintEnd(sprint), nil
+}
+
+func sprintEnd(sprint *Sprint) time.Time {
+	if sprint.CompleteDate != "" {
+		if t, err := ParseTime(sprint.CompleteDate); err == nil {
+			return t
+		}
+	}
+	return time.Now()
+}
+
+func sprintDays(start, end time.Time) []time.Time {
+	start = start.Local()
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+
+	var days []time.Time
+	for !day.After(end) {
+		days = append(days, day)
+		day = day.AddDate(0, 0, 1)
+	}
+	return days
+}
+
+func issueProjects(issues []Issue) []string {
+	seen := make(map[string]bool)
+	var projects []string
+	for _, issue := range issues {
+		key := issue.Fields.Project.Key
+		if key != "" && !seen[key] {
+			seen[key] = true
+			projects = append(projects, key)
+		}
+	}
+	return projects
+}
+
+func isSprintItem(item ChangelogItem) bool {
+	return strings.EqualFold(item.Field, "Sprint")
+}
+
+func containsID(list, id string) bool {
+	for _, v := range strings.Split(list, ",") {
+		if strings.TrimSpace(v) == id {
+			return true
+		}
+	}
+	return false
+}
+
+func inSprintAt(histories []ChangelogHistory, sprintID string, t time.Time, currentlyIn bool) bool {
+	in := currentlyIn
+	for i := len(histories) - 1; i >= 0; i-- {
+		if !histories[i].CreatedTime().After(t) {
+			break
+		}
+		for _, item := range histories[i].Items {
+			if isSprintItem(item) {
+				in = containsID(item.From, sprintID)
+			}
+		}
+	}
+	return in
+}
+
+func addedDuring(histories []ChangelogHistory, sprintID string, start, end time.Time) bool {
+	for _, h := range histories {
+		created := h.CreatedTime()
+		if !created.After(start) || created.After(end) {
+			continue
+		}
+		for _, item := range h.Items {
+			if isSprintItem(item) && containsID(item.To, sprintID) && !containsID(item.From, sprintID) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func statusAt(issue Issue, histories []ChangelogHistory, t time.Time) string {
+	status := issue.Fields.Status.ID
+	for i := len(histories) - 1; i >= 0; i-- {
+		if !histories[i].CreatedTime().After(t) {
+			break
+		}
+		for _, item := range histories[i].Items {
+			if item.Field == "status" {
+				status = item.From
+			}
+		}
+	}
+	return status
+}
+
+func pointsAt(issue Issue, histories []ChangelogHistory, field *Field, t time.Time) float64 {
+	points := issue.Fields.Number(field.ID)
+	for i := len(histories) - 1; i >= 0; i-- {
+		if !histories[i].CreatedTime().After(t) {
+			break
+		}
+		for _, item := range histories[i].Items {
+			if item.IsField(field) {
+				points, _ = strconv.ParseFloat(strings.TrimSpace(item.FromString), 64)
+			}
+		}
+	}
+	return points
+}
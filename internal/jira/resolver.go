@@ -0,0 +1,159 @@
+package jira
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const userCacheTTL = 7 * 24 * time.Hour
+
+type AmbiguousUserError struct {
+	Input      string
+	Candidates []UserSearchResult
+}
+
+func (e *AmbiguousUserError) Error() string {
+	candidates := make([]string, len(e.Candidates))
+	for i, u := range e.Candidates {
+		candidates[i] = describeUser(u)
+	}
+	return fmt.Sprintf("'%s' matches %d users: %s", e.Input, len(e.Candidates), strings.Join(candidates, "; "))
+}
+
+type cachedUser struct {
+	User     UserSearchResult `json:"user"`
+	CachedAt time.Time        `json:"cachedAt"`
+}
+
+type UserResolver struct {
+	client *Client
+	cache  map[string]cachedUser
+	Prompt func(input string, candidates []UserSearchResult) (*UserSearchResult, error)
+
+	AssignableIssue string
+}
+
+func NewUserResolver(client *Client) *UserResolver {
+	return &UserResolver{client: client}
+}
+
+func (r *UserResolver) Resolve(input string) (*UserSearchResult, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return nil, fmt.Errorf("user is required")
+	}
+
+	if strings.EqualFold(input, "me") {
+		return r.client.GetCurrentUser()
+	}
+
+	if r.AssignableIssue != "" {
+		return r.lookup(input)
+	}
+
+	cacheKey := strings.ToLower(input)
+	r.loadCache()
+	if entry, ok := r.cache[cacheKey]; ok && time.Since(entry.CachedAt) < userCacheTTL {
+		return &entry.User, nil
+	}
+
+	user, err := r.lookup(input)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache[cacheKey] = cachedUser{User: *user, CachedAt: time.Now()}
+	r.saveCache()
+	return user, nil
+}
+
+func (r *UserResolver) lookup(input string) (*UserSearchResult, error) {
+	var users []UserSearchResult
+	var err error
+	if r.AssignableIssue != "" {
+		users, err = r.client.SearchAssignableUsers(input, "", r.AssignableIssue)
+	} else {
+		users, err = r.client.SearchUsers(input)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to search for user: %w", err)
+	}
+
+	var exact []UserSearchResult
+	for _, u := range users {
+		if u.AccountID == input || u.Name == input || u.Key == input ||
+			strings.EqualFold(u.EmailAddress, input) || strings.EqualFold(u.DisplayName, input) {
+			exact = append(exact, u)
+		}
+	}
+
+	candidates := exact
+	if len(candidates) == 0 {
+		candidates = users
+	}
+
+	switch len(candidates) {
+	case 0:
+		if user, err := r.client.GetUser(input); err == nil && (r.AssignableIssue == "" || r.assignable(user)) {
+			return user, nil
+		}
+		if r.AssignableIssue != "" {
+			return nil, fmt.Errorf("no assignable user found for %s matching: %s", r.AssignableIssue, input)
+		}
+		return nil, fmt.Errorf("no user found matching: %s", input)
+	case 1:
+		return &candidates[0], nil
+	}
+
+	if r.Prompt != nil {
+		return r.Prompt(input, candidates)
+	}
+	return nil, &AmbiguousUserError{Input: input, Candidates: candidates}
+}
+
+func (r *UserResolver) assignable(user *UserSearchResult) bool {
+	query := user.Name
+	if r.client.isCloud {
+		query = user.DisplayName
+	}
+
+	users, err := r.client.SearchAssignableUsers(query, "", r.AssignableIssue)
+	if err != nil {
+		return false
+	}
+	for _, u := range users {
+		if u.GetIdentifier(r.client.isCloud) == user.GetIdentifier(r.client.isCloud) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *UserResolver) loadCache() {
+	if r.cache != nil {
+		return
+	}
+	r.cache = make(map[string]cachedUser)
+	r.client.readCache("users", &r.cache)
+}
+
+func (r *UserResolver) saveCache() {
+	for key, entry := range r.cache {
+		if time.Since(entry.CachedAt) >= userCacheTTL {
+			delete(r.cache, key)
+		}
+	}
+	r.client.writeCache("users", r.cache)
+}
+
+func describeUser(u UserSearchResult) string {
+	id := u.AccountID
+	if id == "" {
+		id = u.Name
+	}
+	if u.EmailAddress != "" {
+		return fmt.Sprintf("%s <%s> (%s)", u.DisplayName, u.EmailAddress, id)
+	}
+	return fmt.Sprintf("%s (%s)", u.DisplayName, id)
+}
@@ -0,0 +1,57 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func (c *Client) GetStatuses() ([]Status, error) {
+	data, err := c.Get("/status")
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []Status
+	if err := json.Unmarshal(data, &statuses); err != nil {
+		return nil, fmt.Errorf("failed to parse statuses: %w", err)
+	}
+
+	return statuses, nil
+}
+
+func (c *Client) GetStatusCategories() (map[string]string, error) {
+	statuses, err := c.GetStatuses()
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make(map[string]string, len(statuses))
+	for _, s := range statuses {
+		if s.StatusCategory != nil {
+			categories[s.ID] = s.StatusCategory.Key
+		}
+	}
+
+	return categories, nil
+}
+
+type IssueTypeStatuses struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	Subtask  bool     `json:"subtask"`
+	Statuses []Status `json:"statuses"`
+}
+
+func (c *Client) GetProjectStatuses(projectKey string) ([]IssueTypeStatuses, error) {
+	data, err := c.Get(fmt.Sprintf("/project/%s/statuses", projectKey))
+	if err != nil {
+		return nil, err
+	}
+
+	var types []IssueTypeStatuses
+	if err := json.Unmarshal(data, &types); err != nil {
+		return nil, fmt.Errorf("failed to parse project statuses: %w", err)
+	}
+
+	return types, nil
+}
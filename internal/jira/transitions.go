@@ -3,13 +3,29 @@ package jira
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 )
 
 type Transition struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-	To   Status `json:"to"`
+	ID     string                     `json:"id"`
+	Name   string                     `json:"name"`
+	To     Status                     `json:"to"`
+	Fields map[string]TransitionField `json:"fields,omitempty"`
+}
+
+type TransitionField struct {
+	Required        bool           `json:"required"`
+	Name            string         `json:"name"`
+	Schema          FieldSchema    `json:"schema"`
+	HasDefaultValue bool           `json:"hasDefaultValue,omitempty"`
+	AllowedValues   []AllowedValue `json:"allowedValues,omitempty"`
+}
+
+type AllowedValue struct {
+	ID    string `json:"id,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value,omitempty"`
 }
 
 type TransitionsResponse struct {
@@ -17,15 +33,34 @@ type TransitionsResponse struct {
 }
 
 type DoTransitionRequest struct {
-	Transition TransitionID `json:"transition"`
+	Transition TransitionID           `json:"transition"`
+	Fields     map[string]interface{} `json:"fields,omitempty"`
+	Update     map[string]interface{} `json:"update,omitempty"`
 }
 
 type TransitionID struct {
 	ID string `json:"id"`
 }
 
+type TransitionOptions struct {
+	Resolution string
+	Comment    string
+	Fields     map[string]string
+}
+
+func (t Transition) RequiredFields() []string {
+	var required []string
+	for id, f := range t.Fields {
+		if f.Required && !f.HasDefaultValue {
+			required = append(required, id)
+		}
+	}
+	sort.Strings(required)
+	return required
+}
+
 func (c *Client) GetTransitions(issueKey string) (*TransitionsResponse, error) {
-	data, err := c.Get(fmt.Sprintf("/issue/%s/transitions", issueKey))
+	data, err := c.Get(fmt.Sprintf("/issue/%s/transitions?expand=transitions.fields", issueKey))
 	if err != nil {
 		return nil, err
 	}
@@ -38,29 +73,145 @@ func (c *Client) GetTransitions(issueKey string) (*TransitionsResponse, error) {
 	return &resp, nil
 }
 
-func (c *Client) DoTransition(issueKey, transitionNameOrID string) error {
+func (c *Client) FindTransition(issueKey, transitionNameOrID string) (*Transition, error) {
 	transitions, err := c.GetTransitions(issueKey)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	var transitionID string
-	for _, t := range transitions.Transitions {
+	for i, t := range transitions.Transitions {
 		if t.ID == transitionNameOrID || strings.EqualFold(t.Name, transitionNameOrID) {
-			transitionID = t.ID
-			break
+			return &transitions.Transitions[i], nil
 		}
 	}
 
-	if transitionID == "" {
-		available := make([]string, len(transitions.Transitions))
-		for i, t := range transitions.Transitions {
-			available[i] = fmt.Sprintf("%s (%s)", t.Name, t.ID)
-		}
-		return fmt.Errorf("transition '%s' not found. Available: %s", transitionNameOrID, strings.Join(available, ", "))
+	available := make([]string, len(transitions.Transitions))
+	for i, t := range transitions.Transitions {
+		available[i] = fmt.Sprintf("%s (%s)", t.Name, t.ID)
+	}
+	return nil, fmt.Errorf("transition '%s' not found. Available: %s", transitionNameOrID, strings.Join(available, ", "))
+}
+
+func (c *Client) DoTransition(issueKey, transitionNameOrID string) error {
+	return c.DoTransitionWithOptions(issueKey, transitionNameOrID, TransitionOptions{})
+}
+
+func (c *Client) DoTransitionWithOptions(issueKey, transitionNameOrID string, opts TransitionOptions) error {
+	transition, err := c.FindTransition(issueKey, transitionNameOrID)
+	if err != nil {
+		return err
+	}
+
+	req, err := c.buildTransitionRequest(transition, opts)
+	if err != nil {
+		return err
 	}
 
-	req := DoTransitionRequest{Transition: TransitionID{ID: transitionID}}
 	_, err = c.Post(fmt.Sprintf("/issue/%s/transitions", issueKey), req)
 	return err
 }
+
+func (c *Client) buildTransitionRequest(transition *Transition, opts TransitionOptions) (*DoTransitionRequest, error) {
+	req := &DoTransitionRequest{
+		Transition: TransitionID{ID: transition.ID},
+		Fields:     make(map[string]interface{}),
+	}
+
+	if opts.Resolution != "" {
+		if _, _, err := transitionField(transition, "resolution"); err != nil {
+			return nil, err
+		}
+		if meta := transition.Fields["resolution"]; len(meta.AllowedValues) > 0 {
+			match := findAllowed(meta.AllowedValues, opts.Resolution)
+			if match == nil {
+				return nil, fmt.Errorf("resolution '%s' is not allowed. Allowed: %s", opts.Resolution, describeAllowed(meta.AllowedValues))
+			}
+			opts.Resolution = match.Name
+		}
+		req.Fields["resolution"] = map[string]string{"name": opts.Resolution}
+	}
+
+	for nameOrID, raw := range opts.Fields {
+		field, meta, err := transitionField(transition, nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		if len(meta.AllowedValues) > 0 && !allowed(meta.AllowedValues, raw) {
+			return nil, fmt.Errorf("value '%s' is not allowed for %s. Allowed: %s", raw, field.Name, describeAllowed(meta.AllowedValues))
+		}
+		value, err := c.FieldValue(field, raw)
+		if err != nil {
+			return nil, err
+		}
+		req.Fields[field.ID] = value
+	}
+
+	if opts.Comment != "" {
+		req.Update = map[string]interface{}{
+			"comment": []map[string]interface{}{
+				{"add": map[string]string{"body": opts.Comment}},
+			},
+		}
+	}
+
+	var missing []string
+	for _, id := range transition.RequiredFields() {
+		if _, ok := req.Fields[id]; !ok {
+			missing = append(missing, fmt.Sprintf("%s (%s)", transition.Fields[id].Name, id))
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("transition '%s' requires: %s", transition.Name, strings.Join(missing, ", "))
+	}
+
+	if len(req.Fields) == 0 {
+		req.Fields = nil
+	}
+	return req, nil
+}
+
+func transitionField(transition *Transition, nameOrID string) (*Field, *TransitionField, error) {
+	names := make([]string, 0, len(transition.Fields))
+	for id, meta := range transition.Fields {
+		if id == nameOrID || strings.EqualFold(meta.Name, nameOrID) {
+			meta := meta
+			return &Field{ID: id, Name: meta.Name, Schema: meta.Schema}, &meta, nil
+		}
+		names = append(names, fmt.Sprintf("%s (%s)", meta.Name, id))
+	}
+
+	if len(names) == 0 {
+		return nil, nil, fmt.Errorf("field '%s' cannot be set: transition '%s' has no screen", nameOrID, transition.Name)
+	}
+	sort.Strings(names)
+	return nil, nil, fmt.Errorf("field '%s' is not on the screen of transition '%s'. Screen fields: %s", nameOrID, transition.Name, strings.Join(names, ", "))
+}
+
+func findAllowed(values []AllowedValue, raw string) *AllowedValue {
+	for i, v := range values {
+		if v.ID == raw || strings.EqualFold(v.Name, raw) || strings.EqualFold(v.Value, raw) {
+			return &values[i]
+		}
+	}
+	return nil
+}
+
+func allowed(values []AllowedValue, raw string) bool {
+	for _, part := range strings.Split(raw, ",") {
+		if findAllowed(values, strings.TrimSpace(part)) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func describeAllowed(values []AllowedValue) string {
+	names := make([]string, len(values))
+	for i, v := range values {
+		names[i] = v.Name
+		if names[i] == "" {
+			names[i] = v.Value
+		}
+	}
+	return strings.Join(names, ", ")
+}
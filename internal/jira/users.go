@@ -6,6 +6,14 @@ import (
 	"net/url"
 )
 
+type GroupMembersResponse struct {
+	StartAt    int                `json:"startAt"`
+	MaxResults int                `json:"maxResults"`
+	Total      int                `json:"total"`
+	IsLast     bool               `json:"isLast"`
+	Values     []UserSearchResult `json:"values"`
+}
+
 type UserSearchResult struct {
 	AccountID    string `json:"accountId,omitempty"`
 	Name         string `json:"name,omitempty"`
@@ -37,9 +45,34 @@ func (c *Client) GetCurrentUser() (*UserSearchResult, error) {
 	return &user, nil
 }
 
+func (c *Client) GetUser(identifier string) (*UserSearchResult, error) {
+	params := url.Values{}
+	if c.isCloud {
+		params.Set("accountId", identifier)
+	} else {
+		params.Set("username", identifier)
+	}
+
+	data, err := c.Get("/user?" + params.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	var user UserSearchResult
+	if err := json.Unmarshal(data, &user); err != nil {
+		return nil, fmt.Errorf("failed to parse user: %w", err)
+	}
+
+	return &user, nil
+}
+
 func (c *Client) SearchUsers(query string) ([]UserSearchResult, error) {
 	params := url.Values{}
-	params.Set("username", query)
+	if c.isCloud {
+		params.Set("query", query)
+	} else {
+		params.Set("username", query)
+	}
 	params.Set("maxResults", "50")
 
 	data, err := c.Get("/user/search?" + params.Encode())
@@ -55,6 +88,63 @@ func (c *Client) SearchUsers(query string) ([]UserSearchResult, error) {
 	return users, nil
 }
 
+func (c *Client) SearchAssignableUsers(query, projectKey, issueKey string) ([]UserSearchResult, error) {
+	params := url.Values{}
+	if query != "" {
+		if c.isCloud {
+			params.Set("query", query)
+		} else {
+			params.Set("username", query)
+		}
+	}
+	if issueKey != "" {
+		params.Set("issueKey", issueKey)
+	} else {
+		params.Set("project", projectKey)
+	}
+	params.Set("maxResults", "50")
+
+	data, err := c.Get("/user/assignable/search?" + params.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	var users []UserSearchResult
+	if err := json.Unmarshal(data, &users); err != nil {
+		return nil, fmt.Errorf("failed to parse users: %w", err)
+	}
+
+	return users, nil
+}
+
+func (c *Client) GetGroupMembers(group string, includeInactive bool) ([]UserSearchResult, error) {
+	var users []UserSearchResult
+	for {
+		params := url.Values{}
+		params.Set("groupname", group)
+		params.Set("includeInactiveUsers", fmt.Sprintf("%t", includeInactive))
+		params.Set("startAt", fmt.Sprintf("%d", len(users)))
+		params.Set("maxResults", "50")
+
+		data, err := c.Get("/group/member?" + params.Encode())
+		if err != nil {
+			return nil, err
+		}
+
+		var page GroupMembersResponse
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("failed to parse group members: %w", err)
+		}
+
+		users = append(users, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	return users, nil
+}
+
 func (c *Client) AssignIssue(issueKey, userIdentifier string) error {
 	var body map[string]interface{}
 	if userIdentifier == "" {
@@ -0,0 +1,122 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type Version struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Released    bool   `json:"released"`
+	Archived    bool   `json:"archived"`
+	StartDate   string `json:"startDate,omitempty"`
+	ReleaseDate string `json:"releaseDate,omitempty"`
+	ProjectID   int    `json:"projectId,omitempty"`
+}
+
+type CreateVersionRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	ProjectID   int    `json:"projectId"`
+	StartDate   string `json:"startDate,omitempty"`
+	ReleaseDate string `json:"releaseDate,omitempty"`
+}
+
+func (c *Client) GetProjectVersions(projectKey string) ([]Version, error) {
+	data, err := c.Get(fmt.Sprintf("/project/%s/versions", projectKey))
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []Version
+	if err := json.Unmarshal(data, &versions); err != nil {
+		return nil, fmt.Errorf("failed to parse versions: %w", err)
+	}
+
+	return versions, nil
+}
+
+func (c *Client) FindVersion(projectKey, nameOrID string) (*Version, error) {
+	versions, err := c.GetProjectVersions(projectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, v := range versions {
+		if v.ID == nameOrID || strings.EqualFold(v.Name, nameOrID) {
+			return &versions[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("version '%s' not found in project %s", nameOrID, projectKey)
+}
+
+func (c *Client) CreateVersion(projectKey string, req CreateVersionRequest) (*Version, error) {
+	project, err := c.GetProject(projectKey)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectID, err = strconv.Atoi(project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid project ID: %s", project.ID)
+	}
+
+	data, err := c.Post("/version", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var version Version
+	if err := json.Unmarshal(data, &version); err != nil {
+		return nil, fmt.Errorf("failed to parse version: %w", err)
+	}
+
+	return &version, nil
+}
+
+func (c *Client) UpdateVersion(versionID string, fields map[string]interface{}) (*Version, error) {
+	data, err := c.Put(fmt.Sprintf("/version/%s", versionID), fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var version Version
+	if err := json.Unmarshal(data, &version); err != nil {
+		return nil, fmt.Errorf("failed to parse version: %w", err)
+	}
+
+	return &version, nil
+}
+
+func (c *Client) MergeVersion(versionID, targetVersionID string) error {
+	_, err := c.Put(fmt.Sprintf("/version/%s/mergeto/%s", versionID, targetVersionID), nil)
+	return err
+}
+
+func (c *Client) MoveUnresolvedIssues(projectKey string, from, to *Version) ([]string, error) {
+	jql := fmt.Sprintf("project = %s AND fixVersion = %s AND resolution is EMPTY", projectKey, from.ID)
+	issues, err := c.SearchAllIssues(jql, []string{"key"}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var moved []string
+	for _, issue := range issues {
+		update := map[string]interface{}{
+			"fixVersions": []map[string]interface{}{
+				{"remove": map[string]string{"id": from.ID}},
+				{"add": map[string]string{"id": to.ID}},
+			},
+		}
+		if err := c.EditIssue(issue.Key, nil, update); err != nil {
+			return moved, fmt.Errorf("failed to move %s: %w", issue.Key, err)
+		}
+		moved = append(moved, issue.Key)
+	}
+
+	return moved, nil
+}
@@ -0,0 +1,74 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+type WatchersResponse struct {
+	WatchCount int                `json:"watchCount"`
+	IsWatching bool               `json:"isWatching"`
+	Watchers   []UserSearchResult `json:"watchers"`
+}
+
+type VotesResponse struct {
+	Votes    int                `json:"votes"`
+	HasVoted bool               `json:"hasVoted"`
+	Voters   []UserSearchResult `json:"voters,omitempty"`
+}
+
+func (c *Client) GetWatchers(issueKey string) (*WatchersResponse, error) {
+	data, err := c.Get(fmt.Sprintf("/issue/%s/watchers", issueKey))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp WatchersResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse watchers: %w", err)
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) AddWatcher(issueKey, userIdentifier string) error {
+	_, err := c.Post(fmt.Sprintf("/issue/%s/watchers", issueKey), userIdentifier)
+	return err
+}
+
+func (c *Client) RemoveWatcher(issueKey, userIdentifier string) error {
+	params := url.Values{}
+	if c.isCloud {
+		params.Set("accountId", userIdentifier)
+	} else {
+		params.Set("username", userIdentifier)
+	}
+
+	_, err := c.Delete(fmt.Sprintf("/issue/%s/watchers?%s", issueKey, params.Encode()))
+	return err
+}
+
+func (c *Client) GetVotes(issueKey string) (*VotesResponse, error) {
+	data, err := c.Get(fmt.Sprintf("/issue/%s/votes", issueKey))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp VotesResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse votes: %w", err)
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) AddVote(issueKey string) error {
+	_, err := c.Post(fmt.Sprintf("/issue/%s/votes", issueKey), nil)
+	return err
+}
+
+func (c *Client) RemoveVote(issueKey string) error {
+	_, err := c.Delete(fmt.Sprintf("/issue/%s/votes", issueKey))
+	return err
+}
@@ -0,0 +1,380 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+type WorkflowEdge struct {
+	TransitionID string `json:"transitionId"`
+	Name         string `json:"name"`
+	To           string `json:"to"`
+}
+
+type WorkflowStep struct {
+	From string       `json:"from"`
+	Edge WorkflowEdge `json:"transition"`
+}
+
+type WorkflowGraph struct {
+	Project    string                    `json:"project"`
+	IssueType  string                    `json:"issueType"`
+	Name       string                    `json:"name,omitempty"`
+	Source     string                    `json:"source,omitempty"`
+	Edges      map[string][]WorkflowEdge `json:"edges"`
+	Categories map[string]string         `json:"categories,omitempty"`
+}
+
+type workflowSchemeAssociations struct {
+	Values []struct {
+		WorkflowScheme struct {
+			DefaultWorkflow   string            `json:"defaultWorkflow"`
+			IssueTypeMappings map[string]string `json:"issueTypeMappings"`
+		} `json:"workflowScheme"`
+	} `json:"values"`
+}
+
+type workflowSearchResult struct {
+	Values []struct {
+		ID struct {
+			Name string `json:"name"`
+		} `json:"id"`
+		Statuses []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"statuses"`
+		Transitions []struct {
+			ID   string   `json:"id"`
+			Name string   `json:"name"`
+			From []string `json:"from"`
+			To   string   `json:"to"`
+			Type string   `json:"type"`
+		} `json:"transitions"`
+	} `json:"values"`
+}
+
+type MovePlan struct {
+	Issue     string         `json:"issue"`
+	From      string         `json:"from"`
+	To        string         `json:"to"`
+	Steps     []WorkflowStep `json:"steps"`
+	Unknown   []string       `json:"unexplored,omitempty"`
+	Reachable bool           `json:"reachable"`
+}
+
+type MoveOptions struct {
+	MaxSteps   int
+	Resolution string
+	Comment    string
+	OnStep     func(step WorkflowStep)
+}
+
+func (g *WorkflowGraph) Learn(from string, transitions []Transition) {
+	if g.Edges == nil {
+		g.Edges = make(map[string][]WorkflowEdge)
+	}
+	edges := make([]WorkflowEdge, len(transitions))
+	for i, t := range transitions {
+		edges[i] = WorkflowEdge{TransitionID: t.ID, Name: t.Name, To: t.To.Name}
+	}
+	g.Edges[from] = edges
+}
+
+func (g *WorkflowGraph) Statuses() []string {
+	seen := make(map[string]bool)
+	for status := range g.Categories {
+		seen[status] = true
+	}
+	for from, edges := range g.Edges {
+		seen[from] = true
+		for _, e := range edges {
+			seen[e.To] = true
+		}
+	}
+	statuses := make([]string, 0, len(seen))
+	for s := range seen {
+		statuses = append(statuses, s)
+	}
+	sort.Strings(statuses)
+	return statuses
+}
+
+func (g *WorkflowGraph) Unexplored() []string {
+	var unexplored []string
+	for _, s := range g.Statuses() {
+		if _, ok := g.Edges[s]; !ok {
+			unexplored = append(unexplored, s)
+		}
+	}
+	return unexplored
+}
+
+func (g *WorkflowGraph) Path(from, to string) []WorkflowStep {
+	return g.search(from, func(status string) bool {
+		return strings.EqualFold(status, to)
+	})
+}
+
+func (g *WorkflowGraph) pathToUnexplored(from string) []WorkflowStep {
+	return g.search(from, func(status string) bool {
+		_, explored := g.Edges[status]
+		return !explored
+	})
+}
+
+func (g *WorkflowGraph) search(from string, goal func(status string) bool) []WorkflowStep {
+	if goal(from) {
+		return []WorkflowStep{}
+	}
+
+	prev := map[string]WorkflowStep{}
+	visited := map[string]bool{from: true}
+	queue := []string{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, edge := range g.Edges[current] {
+			if visited[edge.To] {
+				continue
+			}
+			visited[edge.To] = true
+			prev[edge.To] = WorkflowStep{From: current, Edge: edge}
+
+			if goal(edge.To) {
+				var path []WorkflowStep
+				for status := edge.To; status != from; status = prev[status].From {
+					path = append([]WorkflowStep{prev[status]}, path...)
+				}
+				return path
+			}
+			queue = append(queue, edge.To)
+		}
+	}
+	return nil
+}
+
+func (c *Client) LoadWorkflowGraph(project, issueType string) *WorkflowGraph {
+	graphs := make(map[string]*WorkflowGraph)
+	c.readCache("workflows", &graphs)
+
+	if g, ok := graphs[workflowKey(project, issueType)]; ok && g != nil {
+		return g
+	}
+	return &WorkflowGraph{Project: project, IssueType: issueType, Edges: make(map[string][]WorkflowEdge)}
+}
+
+func (c *Client) SaveWorkflowGraph(g *WorkflowGraph) {
+	graphs := make(map[string]*WorkflowGraph)
+	c.readCache("workflows", &graphs)
+	graphs[workflowKey(g.Project, g.IssueType)] = g
+	c.writeCache("workflows", graphs)
+}
+
+func workflowKey(project, issueType string) string {
+	return strings.ToUpper(project) + "/" + strings.ToLower(issueType)
+}
+
+func (c *Client) observeWorkflow(issueKey string) (*Issue, *WorkflowGraph, error) {
+	data, err := c.Get(fmt.Sprintf("/issue/%s?fields=status,project,issuetype", issueKey))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var issue Issue
+	if err := json.Unmarshal(data, &issue); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse issue: %w", err)
+	}
+
+	transitions, err := c.GetTransitions(issueKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	graph := c.LoadWorkflowGraph(issue.Fields.Project.Key, issue.Fields.IssueType.Name)
+	graph.Learn(issue.Fields.Status.Name, transitions.Transitions)
+	c.SaveWorkflowGraph(graph)
+
+	return &issue, graph, nil
+}
+
+func (c *Client) PlanMove(issueKey, target string) (*MovePlan, error) {
+	issue, graph, err := c.observeWorkflow(issueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &MovePlan{Issue: issueKey, From: issue.Fields.Status.Name, To: target}
+	if path := graph.Path(plan.From, target); path != nil {
+		plan.Steps = path
+		plan.Reachable = true
+		return plan, nil
+	}
+
+	plan.Unknown = graph.Unexplored()
+	return plan, nil
+}
+
+func (c *Client) MoveIssue(issueKey, target string, opts MoveOptions) ([]WorkflowStep, error) {
+	if opts.MaxSteps <= 0 {
+		opts.MaxSteps = 10
+	}
+
+	var taken []WorkflowStep
+	for {
+		issue, graph, err := c.observeWorkflow(issueKey)
+		if err != nil {
+			return taken, err
+		}
+
+		current := issue.Fields.Status.Name
+		if strings.EqualFold(current, target) {
+			return taken, nil
+		}
+		if len(taken) >= opts.MaxSteps {
+			return taken, fmt.Errorf("status '%s' not reached after %d transitions (currently '%s')", target, len(taken), current)
+		}
+
+		path := graph.Path(current, target)
+		if path == nil {
+			path = graph.pathToUnexplored(current)
+		}
+		if len(path) == 0 {
+			return taken, fmt.Errorf("no path from '%s' to '%s'. Known statuses: %s", current, target, strings.Join(graph.Statuses(), ", "))
+		}
+
+		step := path[0]
+		var stepOpts TransitionOptions
+		if strings.EqualFold(step.Edge.To, target) {
+			stepOpts = TransitionOptions{Resolution: opts.Resolution, Comment: opts.Comment}
+		}
+		if err := c.DoTransitionWithOptions(issueKey, step.Edge.TransitionID, stepOpts); err != nil {
+			return taken, fmt.Errorf("failed to transition from '%s' via '%s': %w", step.From, step.Edge.Name, err)
+		}
+
+		taken = append(taken, step)
+		if opts.OnStep != nil {
+			opts.OnStep(step)
+		}
+	}
+}
+
+func (c *Client) GetWorkflow(projectKey, issueTypeName string) (*WorkflowGraph, error) {
+	types, err := c.GetProjectStatuses(projectKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project statuses: %w", err)
+	}
+
+	var issueType *IssueTypeStatuses
+	available := make([]string, len(types))
+	for i, t := range types {
+		available[i] = t.Name
+		if strings.EqualFold(t.Name, issueTypeName) || t.ID == issueTypeName {
+			issueType = &types[i]
+		}
+	}
+	if issueType == nil {
+		return nil, fmt.Errorf("issue type '%s' not found in %s. Available: %s", issueTypeName, projectKey, strings.Join(available, ", "))
+	}
+
+	var graph *WorkflowGraph
+	if c.isCloud {
+		graph, err = c.fetchWorkflow(projectKey, issueType.ID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		graph = c.LoadWorkflowGraph(projectKey, issueType.Name)
+		graph.Source = "learned"
+	}
+
+	graph.Project = projectKey
+	graph.IssueType = issueType.Name
+	graph.Categories = make(map[string]string, len(issueType.Statuses))
+	for _, s := range issueType.Statuses {
+		if s.StatusCategory != nil {
+			graph.Categories[s.Name] = s.StatusCategory.Key
+		} else {
+			graph.Categories[s.Name] = ""
+		}
+	}
+
+	return graph, nil
+}
+
+func (c *Client) fetchWorkflow(projectKey, issueTypeID string) (*WorkflowGraph, error) {
+	project, err := c.GetProject(projectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.Get("/workflowscheme/project?projectId=" + project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow scheme: %w", err)
+	}
+
+	var schemes workflowSchemeAssociations
+	if err := json.Unmarshal(data, &schemes); err != nil {
+		return nil, fmt.Errorf("failed to parse workflow scheme: %w", err)
+	}
+	if len(schemes.Values) == 0 {
+		return nil, fmt.Errorf("no workflow scheme found for %s", projectKey)
+	}
+
+	scheme := schemes.Values[0].WorkflowScheme
+	name := scheme.IssueTypeMappings[issueTypeID]
+	if name == "" {
+		name = scheme.DefaultWorkflow
+	}
+
+	params := url.Values{}
+	params.Set("workflowName", name)
+	params.Set("expand", "statuses,transitions")
+	data, err = c.Get("/workflow/search?" + params.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow: %w", err)
+	}
+
+	var result workflowSearchResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse workflow: %w", err)
+	}
+	if len(result.Values) == 0 {
+		return nil, fmt.Errorf("workflow '%s' not found", name)
+	}
+
+	workflow := result.Values[0]
+	names := make(map[string]string, len(workflow.Statuses))
+	for _, s := range workflow.Statuses {
+		names[s.ID] = s.Name
+	}
+
+	graph := &WorkflowGraph{Name: workflow.ID.Name, Source: "workflow", Edges: make(map[string][]WorkflowEdge)}
+	for _, t := range workflow.Transitions {
+		to, ok := names[t.To]
+		if !ok || t.Type == "initial" {
+			continue
+		}
+		edge := WorkflowEdge{TransitionID: t.ID, Name: t.Name, To: to}
+
+		from := t.From
+		if t.Type == "global" || len(from) == 0 {
+			from = nil
+			for id := range names {
+				if id != t.To {
+					from = append(from, id)
+				}
+			}
+		}
+		for _, id := range from {
+			if status, ok := names[id]; ok {
+				graph.Edges[status] = append(graph.Edges[status], edge)
+			}
+		}
+	}
+
+	return graph, nil
+}
@@ -0,0 +1,81 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/itchyny/gojq"
+	"github.com/spf13/viper"
+)
+
+func jqQuery() string {
+	return viper.GetString("jq")
+}
+
+func parseJQ() (*gojq.Code, error) {
+	query, err := gojq.Parse(jqQuery())
+	if err != nil {
+		return nil, fmt.Errorf("invalid --jq expression: %w", err)
+	}
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --jq expression: %w", err)
+	}
+	return code, nil
+}
+
+func validateJQ(format string) error {
+	if jqQuery() == "" {
+		return nil
+	}
+	if format != "json" && format != "jsonl" {
+		return fmt.Errorf("--jq only works with -o json or -o jsonl")
+	}
+	_, err := parseJQ()
+	return err
+}
+
+func printJQ(v interface{}, indent bool) error {
+	code, err := parseJQ()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	var input interface{}
+	if err := json.Unmarshal(data, &input); err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	iter := code.Run(input)
+	for {
+		result, ok := iter.Next()
+		if !ok {
+			return nil
+		}
+		if err, ok := result.(error); ok {
+			if halt, ok := err.(*gojq.HaltError); ok && halt.Value() == nil {
+				return nil
+			}
+			return fmt.Errorf("jq: %w", err)
+		}
+
+		if s, ok := result.(string); ok {
+			fmt.Println(s)
+			continue
+		}
+		var out []byte
+		if indent {
+			out, err = json.MarshalIndent(result, "", "  ")
+		} else {
+			out, err = json.Marshal(result)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(out))
+	}
+}
@@ -0,0 +1,154 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/viper"
+	"go.yaml.in/yaml/v3"
+)
+
+var Formats = []string{"text", "json", "jsonl", "yaml", "csv", "tsv", "markdown", "template"}
+
+func Format() string {
+	format := strings.ToLower(viper.GetString("output"))
+	if format == "text" && (viper.GetString("template") != "" || viper.GetString("template_file") != "") {
+		return "template"
+	}
+	if format == "text" && jqQuery() != "" {
+		return "json"
+	}
+	return format
+}
+
+func Validate() error {
+	format := Format()
+	for _, f := range Formats {
+		if f == format {
+			return validateJQ(format)
+		}
+	}
+	return fmt.Errorf("unsupported output format '%s' (use %s)", viper.GetString("output"), strings.Join(Formats, ", "))
+}
+
+func Structured() bool {
+	switch Format() {
+	case "json", "jsonl", "yaml", "template":
+		return true
+	}
+	return false
+}
+
+func Human() bool {
+	format := Format()
+	return format == "text" || format == "markdown"
+}
+
+func NoHeader() bool {
+	return viper.GetBool("no_header")
+}
+
+func Print(v interface{}) error {
+	return PrintList(v, v)
+}
+
+func PrintList(v, items interface{}) error {
+	return PrintListAs(Format(), v, items)
+}
+
+func PrintListAs(format string, v, items interface{}) error {
+	switch format {
+	case "json":
+		if jqQuery() != "" {
+			return printJQ(v, true)
+		}
+		return PrintJSON(v)
+	case "jsonl":
+		if jqQuery() != "" {
+			return printJQ(v, false)
+		}
+		return printJSONLines(items)
+	case "yaml":
+		return PrintYAML(v)
+	case "template":
+		return printTemplate(os.Stdout, items)
+	}
+	return fmt.Errorf("unsupported output format '%s' (use %s)", format, strings.Join(Formats, ", "))
+}
+
+func PrintJSON(v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+func printJSONLines(items interface{}) error {
+	rv := reflect.ValueOf(items)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return writeJSONLine(items)
+	}
+	for i := 0; i < rv.Len(); i++ {
+		if err := writeJSONLine(rv.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeJSONLine(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+func PrintYAML(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal YAML: %w", err)
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.UseNumber()
+	var generic interface{}
+	if err := decoder.Decode(&generic); err != nil {
+		return fmt.Errorf("failed to marshal YAML: %w", err)
+	}
+
+	out, err := yaml.Marshal(yamlValue(generic))
+	if err != nil {
+		return fmt.Errorf("failed to marshal YAML: %w", err)
+	}
+	fmt.Print(string(out))
+	return nil
+}
+
+func yamlValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		if f, err := t.Float64(); err == nil {
+			return f
+		}
+		return t.String()
+	case map[string]interface{}:
+		for k, item := range t {
+			t[k] = yamlValue(item)
+		}
+	case []interface{}:
+		for i, item := range t {
+			t[i] = yamlValue(item)
+		}
+	}
+	return v
+}
@@ -0,0 +1,215 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	defaultCellWidth = 50
+	minCellWidth     = 8
+)
+
+type Table struct {
+	Title  string
+	Header []string
+	Rows   [][]string
+	Footer string
+	Empty  string
+}
+
+func NewTable(header ...string) *Table {
+	return &Table{Header: header}
+}
+
+func (t *Table) AddRow(cells ...string) {
+	t.Rows = append(t.Rows, cells)
+}
+
+func (t *Table) AddSection(title, text string) {
+	if !Human() {
+		t.AddRow(title, text)
+		return
+	}
+	if t.Footer != "" {
+		t.Footer += "\n\n"
+	}
+	t.Footer += fmt.Sprintf("### %s\n\n%s", title, text)
+}
+
+func (t *Table) Print() error {
+	return t.Write(os.Stdout, Format())
+}
+
+func (t *Table) Write(w io.Writer, format string) error {
+	switch format {
+	case "text", "markdown":
+		if t.Title != "" {
+			fmt.Fprintf(w, "%s\n\n", t.Title)
+		}
+		if len(t.Rows) == 0 && t.Empty != "" {
+			fmt.Fprintln(w, t.Empty)
+			return nil
+		}
+		if format == "text" {
+			t.writeText(w)
+		} else {
+			t.writeMarkdown(w)
+		}
+		if t.Footer != "" {
+			fmt.Fprintf(w, "\n%s\n", t.Footer)
+		}
+		return nil
+	case "csv":
+		return t.writeCSV(w)
+	case "tsv":
+		return t.writeTSV(w)
+	}
+	records := t.Records()
+	return PrintListAs(format, records, records)
+}
+
+func (t *Table) Records() []map[string]string {
+	records := make([]map[string]string, len(t.Rows))
+	for i, row := range t.Rows {
+		records[i] = make(map[string]string, len(t.Header))
+		for j, h := range t.Header {
+			if j < len(row) {
+				records[i][h] = row[j]
+			}
+		}
+	}
+	return records
+}
+
+func (t *Table) writeText(w io.Writer) {
+	widths := fitColumnWidths(t.Header, t.Rows, TerminalWidth())
+	line := func(cells []string) {
+		fmt.Fprint(w, "|")
+		for i, width := range widths {
+			cell := ""
+			if i < len(cells) {
+				cell = Truncate(cells[i], width)
+			}
+			fmt.Fprintf(w, " %s%s |", cell, strings.Repeat(" ", width-DisplayWidth(cell)))
+		}
+		fmt.Fprintln(w)
+	}
+
+	if !NoHeader() {
+		line(t.Header)
+		fmt.Fprint(w, "|")
+		for _, width := range widths {
+			fmt.Fprintf(w, " %s |", strings.Repeat("-", width))
+		}
+		fmt.Fprintln(w)
+	}
+	for _, row := range t.Rows {
+		line(row)
+	}
+}
+
+func (t *Table) writeMarkdown(w io.Writer) {
+	escape := strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
+	line := func(cells []string) {
+		fmt.Fprint(w, "|")
+		for _, cell := range cells {
+			fmt.Fprintf(w, " %s |", escape.Replace(cell))
+		}
+		fmt.Fprintln(w)
+	}
+
+	if !NoHeader() {
+		line(t.Header)
+		fmt.Fprint(w, "|")
+		for range t.Header {
+			fmt.Fprint(w, " --- |")
+		}
+		fmt.Fprintln(w)
+	}
+	for _, row := range t.Rows {
+		line(row)
+	}
+}
+
+func (t *Table) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if !NoHeader() {
+		if err := cw.Write(t.Header); err != nil {
+			return fmt.Errorf("failed to write CSV: %w", err)
+		}
+	}
+	if err := cw.WriteAll(t.Rows); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
+
+func (t *Table) writeTSV(w io.Writer) error {
+	clean := strings.NewReplacer("\t", " ", "\r\n", " ", "\n", " ")
+	line := func(cells []string) error {
+		values := make([]string, len(cells))
+		for i, cell := range cells {
+			values[i] = clean.Replace(cell)
+		}
+		_, err := fmt.Fprintln(w, strings.Join(values, "\t"))
+		return err
+	}
+
+	if !NoHeader() {
+		if err := line(t.Header); err != nil {
+			return err
+		}
+	}
+	for _, row := range t.Rows {
+		if err := line(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func fitColumnWidths(header []string, rows [][]string, maxWidth int) []int {
+	widths := make([]int, len(header))
+	for i, h := range header {
+		widths[i] = DisplayWidth(h)
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			if w := DisplayWidth(strings.ReplaceAll(cell, "\n", " ")); i < len(widths) && w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+
+	if maxWidth <= 0 {
+		for i := range widths {
+			if widths[i] > defaultCellWidth {
+				widths[i] = defaultCellWidth
+			}
+		}
+		return widths
+	}
+
+	total := 3*len(widths) + 1
+	for _, w := range widths {
+		total += w
+	}
+	for total > maxWidth {
+		widest := -1
+		for i, w := range widths {
+			if w > minCellWidth && (widest < 0 || w > widths[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			break
+		}
+		widths[widest]--
+		total--
+	}
+	return widths
+}
@@ -0,0 +1,291 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+)
+
+var colors = map[string]string{
+	"bold":    "1",
+	"red":     "31",
+	"green":   "32",
+	"yellow":  "33",
+	"blue":    "34",
+	"magenta": "35",
+	"cyan":    "36",
+	"gray":    "90",
+}
+
+var timeLayouts = []string{
+	"2006-01-02T15:04:05.000-0700",
+	"2006-01-02T15:04:05.000Z07:00",
+	time.RFC3339Nano,
+}
+
+var funcs = template.FuncMap{
+	"date":     formatDate,
+	"truncate": truncate,
+	"color":    colorize,
+	"join":     join,
+	"upper":    func(v interface{}) string { return strings.ToUpper(Text(v)) },
+	"lower":    func(v interface{}) string { return strings.ToLower(Text(v)) },
+	"json":     toJSON,
+}
+
+func RegisterFunc(name string, fn interface{}) {
+	funcs[name] = fn
+}
+
+func Uses(fn string) bool {
+	if Format() != "template" {
+		return false
+	}
+	tmpl, err := parseTemplate()
+	if err != nil {
+		return false
+	}
+	for _, t := range tmpl.Templates() {
+		if t.Tree != nil && usesFunc(t.Tree.Root, fn) {
+			return true
+		}
+	}
+	return false
+}
+
+func Text(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case time.Time:
+		return t.Local().Format("2006-01-02 15:04")
+	case json.RawMessage:
+		var value interface{}
+		if err := json.Unmarshal(t, &value); err != nil {
+			return string(t)
+		}
+		return Text(value)
+	case fmt.Stringer:
+		return t.String()
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return ""
+		}
+		return Text(rv.Elem().Interface())
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 64)
+	case reflect.Slice, reflect.Array:
+		return join(", ", v)
+	case reflect.Struct, reflect.Map:
+		data, err := json.Marshal(v)
+		if err != nil {
+			break
+		}
+		var generic map[string]interface{}
+		if err := json.Unmarshal(data, &generic); err != nil {
+			break
+		}
+		for _, key := range []string{"displayName", "name", "value", "key", "title"} {
+			if s, ok := generic[key].(string); ok {
+				return s
+			}
+		}
+		if id, ok := generic["id"]; ok {
+			return Text(id)
+		}
+		return string(data)
+	}
+	return fmt.Sprint(v)
+}
+
+func formatDate(layout string, v interface{}) string {
+	var t time.Time
+	switch d := v.(type) {
+	case time.Time:
+		t = d
+	case *time.Time:
+		if d == nil {
+			return ""
+		}
+		t = *d
+	default:
+		s := Text(v)
+		parsed, ok := parseTime(s)
+		if !ok {
+			return s
+		}
+		t = parsed
+	}
+	return t.Local().Format(layout)
+}
+
+func parseTime(s string) (time.Time, bool) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	if t, err := time.ParseInLocation("2006-01-02", s, time.Local); err == nil {
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+func truncate(max int, v interface{}) string {
+	return Truncate(Text(v), max)
+}
+
+func colorize(name string, v interface{}) (string, error) {
+	code, ok := colors[strings.ToLower(name)]
+	if !ok {
+		return "", fmt.Errorf("unknown color '%s'", name)
+	}
+	s := Text(v)
+	if os.Getenv("NO_COLOR") != "" || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return s, nil
+	}
+	return "\x1b[" + code + "m" + s + "\x1b[0m", nil
+}
+
+func join(sep string, v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return Text(v)
+	}
+
+	parts := make([]string, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		if s := Text(rv.Index(i).Interface()); s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, sep)
+}
+
+func toJSON(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func templateText() (string, error) {
+	if text := viper.GetString("template"); text != "" {
+		return text, nil
+	}
+	if path := viper.GetString("template_file"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read template file: %w", err)
+		}
+		return string(data), nil
+	}
+	return "", fmt.Errorf("--template or --template-file is required with -o template")
+}
+
+func parseTemplate() (*template.Template, error) {
+	text, err := templateText()
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := template.New("output").Funcs(funcs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+	return tmpl, nil
+}
+
+func printTemplate(w io.Writer, data interface{}) error {
+	tmpl, err := parseTemplate()
+	if err != nil {
+		return err
+	}
+
+	rv := reflect.ValueOf(data)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return executeTemplate(w, tmpl, data)
+	}
+	for i := 0; i < rv.Len(); i++ {
+		if err := executeTemplate(w, tmpl, rv.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func executeTemplate(w io.Writer, tmpl *template.Template, data interface{}) error {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed to render template: %w", err)
+	}
+	if buf.Len() == 0 {
+		return nil
+	}
+	if !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+		buf.WriteByte('\n')
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func usesFunc(node parse.Node, fn string) bool {
+	switch n := node.(type) {
+	case *parse.IdentifierNode:
+		return n.Ident == fn
+	case *parse.ListNode:
+		if n == nil {
+			return false
+		}
+		for _, child := range n.Nodes {
+			if usesFunc(child, fn) {
+				return true
+			}
+		}
+	case *parse.ActionNode:
+		return usesFunc(n.Pipe, fn)
+	case *parse.PipeNode:
+		if n == nil {
+			return false
+		}
+		for _, c := range n.Cmds {
+			if usesFunc(c, fn) {
+				return true
+			}
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			if usesFunc(arg, fn) {
+				return true
+			}
+		}
+	case *parse.ChainNode:
+		return usesFunc(n.Node, fn)
+	case *parse.IfNode:
+		return usesFunc(n.Pipe, fn) || usesFunc(n.List, fn) || usesFunc(n.ElseList, fn)
+	case *parse.RangeNode:
+		return usesFunc(n.Pipe, fn) || usesFunc(n.List, fn) || usesFunc(n.ElseList, fn)
+	case *parse.WithNode:
+		return usesFunc(n.Pipe, fn) || usesFunc(n.List, fn) || usesFunc(n.ElseList, fn)
+	case *parse.TemplateNode:
+		return usesFunc(n.Pipe, fn)
+	}
+	return false
+}